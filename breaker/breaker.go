@@ -0,0 +1,159 @@
+package breaker
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type State int
+
+const (
+	Closed State = iota
+	Open
+	HalfOpen
+)
+
+type Clock interface {
+	Now() time.Time
+}
+
+type Options struct {
+	Name             string
+	FailureThreshold int
+	CoolDown         time.Duration
+	Clock            Clock
+}
+
+type Breaker struct {
+	mutex    sync.Mutex
+	options  Options
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+type OpenError struct {
+	Name    string
+	RetryAt time.Time
+}
+
+type systemClock struct{}
+
+var (
+	ErrOpen     = errors.New("Circuit breaker is open")
+	errPanicked = errors.New("Call through circuit breaker panicked")
+)
+
+func New(options Options) *Breaker {
+	if options.FailureThreshold < 1 {
+		options.FailureThreshold = 1
+	}
+	if options.Clock == nil {
+		options.Clock = systemClock{}
+	}
+	return &Breaker{options: options}
+}
+
+func (b *Breaker) Do(fn func() error) error {
+	if err := b.enter(); err != nil {
+		return err
+	}
+
+	outcome := errPanicked
+	defer func() { b.leave(outcome) }()
+
+	outcome = fn()
+	return outcome
+}
+
+func (b *Breaker) Wrap(fn func() error) func() error {
+	return func() error {
+		return b.Do(fn)
+	}
+}
+
+func (b *Breaker) State() State {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.coolDown()
+	return b.state
+}
+
+func (b *Breaker) enter() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.coolDown()
+
+	switch {
+	case b.state == Open:
+		return b.openError()
+	case b.state == HalfOpen && b.probing:
+		return b.openError()
+	case b.state == HalfOpen:
+		b.probing = true
+	}
+	return nil
+}
+
+func (b *Breaker) leave(err error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.state == HalfOpen {
+		b.probing = false
+		if err != nil {
+			b.trip()
+			return
+		}
+		b.state = Closed
+		b.failures = 0
+		return
+	}
+
+	if err == nil {
+		b.failures = 0
+		return
+	}
+
+	if b.failures++; b.failures >= b.options.FailureThreshold {
+		b.trip()
+	}
+}
+
+func (b *Breaker) coolDown() {
+	if b.state == Open && !b.options.Clock.Now().Before(b.retryAt()) {
+		b.state = HalfOpen
+	}
+}
+
+func (b *Breaker) trip() {
+	b.state = Open
+	b.failures = 0
+	b.openedAt = b.options.Clock.Now()
+}
+
+func (b *Breaker) retryAt() time.Time {
+	return b.openedAt.Add(b.options.CoolDown)
+}
+
+func (b *Breaker) openError() error {
+	return &OpenError{b.options.Name, b.retryAt()}
+}
+
+func (e *OpenError) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("Circuit breaker is open until %v", e.RetryAt)
+	}
+	return fmt.Sprintf("Circuit breaker %s is open until %v", e.Name, e.RetryAt)
+}
+
+func (e *OpenError) Unwrap() error {
+	return ErrOpen
+}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,165 @@
+package breaker
+
+import (
+	"errors"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newBreaker() (*Breaker, *fakeClock) {
+	clock := &fakeClock{time.Date(2015, 8, 20, 0, 0, 0, 0, time.UTC)}
+	return New(Options{
+		Name:             "templates",
+		FailureThreshold: 2,
+		CoolDown:         time.Minute,
+		Clock:            clock,
+	}), clock
+}
+
+var errDown = errors.New("Service unavailable")
+
+func failing() error { return errDown }
+func passing() error { return nil }
+
+func TestClosedBreakerCallsProvidedBlock(t *testing.T) {
+	b, _ := newBreaker()
+	executed := false
+
+	err := b.Do(func() error { executed = true; return nil })
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, executed)
+	assert.Equal(t, Closed, b.State())
+}
+
+func TestBreakerOpensAfterFailureThreshold(t *testing.T) {
+	b, _ := newBreaker()
+
+	assert.Equal(t, errDown, b.Do(failing))
+	assert.Equal(t, Closed, b.State())
+	assert.Equal(t, errDown, b.Do(failing))
+	assert.Equal(t, Open, b.State())
+}
+
+func TestSuccessResetsFailures(t *testing.T) {
+	b, _ := newBreaker()
+
+	b.Do(failing)
+	b.Do(passing)
+	b.Do(failing)
+
+	assert.Equal(t, Closed, b.State())
+}
+
+func TestOpenBreakerFailsFast(t *testing.T) {
+	b, clock := newBreaker()
+	b.Do(failing)
+	b.Do(failing)
+	executed := false
+
+	err := b.Do(func() error { executed = true; return nil })
+
+	var openErr *OpenError
+	assert.Equal(t, false, executed)
+	assert.Equal(t, true, errors.As(err, &openErr))
+	assert.Equal(t, "templates", openErr.Name)
+	assert.Equal(t, clock.Now().Add(time.Minute), openErr.RetryAt)
+	assert.Equal(t, true, errors.Is(err, ErrOpen))
+}
+
+func TestBreakerIsHalfOpenAfterCoolDown(t *testing.T) {
+	b, clock := newBreaker()
+	b.Do(failing)
+	b.Do(failing)
+
+	clock.Advance(59 * time.Second)
+	assert.Equal(t, Open, b.State())
+
+	clock.Advance(time.Second)
+	assert.Equal(t, HalfOpen, b.State())
+}
+
+func TestHalfOpenBreakerClosesOnSuccessfulTrial(t *testing.T) {
+	b, clock := newBreaker()
+	b.Do(failing)
+	b.Do(failing)
+	clock.Advance(time.Minute)
+
+	assert.Equal(t, nil, b.Do(passing))
+	assert.Equal(t, Closed, b.State())
+}
+
+func TestHalfOpenBreakerOpensOnFailedTrial(t *testing.T) {
+	b, clock := newBreaker()
+	b.Do(failing)
+	b.Do(failing)
+	clock.Advance(time.Minute)
+
+	assert.Equal(t, errDown, b.Do(failing))
+	assert.Equal(t, Open, b.State())
+}
+
+func TestHalfOpenBreakerAllowsSingleTrial(t *testing.T) {
+	b, clock := newBreaker()
+	b.Do(failing)
+	b.Do(failing)
+	clock.Advance(time.Minute)
+
+	var nested error
+	b.Do(func() error {
+		nested = b.Do(passing)
+		return nil
+	})
+
+	assert.Equal(t, true, errors.Is(nested, ErrOpen))
+	assert.Equal(t, Closed, b.State())
+}
+
+func TestPanickingTrialOpensBreakerAgain(t *testing.T) {
+	b, clock := newBreaker()
+	b.Do(failing)
+	b.Do(failing)
+	clock.Advance(time.Minute)
+
+	err := errorMonad.Try(b.Wrap(func() error { panic("boom") })).Err()
+	assert.IsType(t, &errorMonad.PanicError{}, err)
+	assert.Equal(t, Open, b.State())
+
+	clock.Advance(time.Minute)
+	assert.Equal(t, nil, b.Do(passing))
+	assert.Equal(t, Closed, b.State())
+}
+
+func TestOpenErrorWithoutName(t *testing.T) {
+	retryAt := time.Date(2015, 8, 20, 0, 1, 0, 0, time.UTC)
+	err := &OpenError{RetryAt: retryAt}
+
+	assert.Equal(t, "Circuit breaker is open until "+retryAt.String(), err.Error())
+}
+
+func TestWrapInErrorChain(t *testing.T) {
+	b, _ := newBreaker()
+	calls := 0
+	fetch := b.Wrap(func() error { calls++; return errDown })
+
+	for i := 0; i < 5; i++ {
+		errorMonad.Bind(fetch).Err()
+	}
+
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, true, errors.Is(errorMonad.Bind(fetch).Err(), ErrOpen))
+}
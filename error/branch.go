@@ -0,0 +1,73 @@
+package error
+
+import "errors"
+
+type predicateFunc func() bool
+type matcherFunc func(error) bool
+
+type SwitchCase struct {
+	match matcherFunc
+	fns   []func() error
+}
+
+func When(pred predicateFunc, fns ...func() error) Error {
+	return Return(nil).When(pred, fns...)
+}
+
+func Unless(pred predicateFunc, fns ...func() error) Error {
+	return Return(nil).Unless(pred, fns...)
+}
+
+func IfElse(pred predicateFunc, thenFns, elseFns []func() error) Error {
+	return Return(nil).IfElse(pred, thenFns, elseFns)
+}
+
+func (e Error) When(pred predicateFunc, fns ...func() error) Error {
+	return e.IfElse(pred, fns, nil)
+}
+
+func (e Error) Unless(pred predicateFunc, fns ...func() error) Error {
+	return e.IfElse(pred, nil, fns)
+}
+
+func (e Error) IfElse(pred predicateFunc, thenFns, elseFns []func() error) Error {
+	if e.err != nil {
+		for _, fn := range thenFns {
+			e.skip(nameOf(fn))
+		}
+		for _, fn := range elseFns {
+			e.skip(nameOf(fn))
+		}
+		return e
+	}
+
+	if pred() {
+		return e.Chain(thenFns...)
+	}
+	return e.Chain(elseFns...)
+}
+
+func Case(target error, fns ...func() error) SwitchCase {
+	return CaseFn(func(err error) bool { return errors.Is(err, target) }, fns...)
+}
+
+func CaseFn(match matcherFunc, fns ...func() error) SwitchCase {
+	return SwitchCase{match, fns}
+}
+
+func Default(fns ...func() error) SwitchCase {
+	return CaseFn(func(error) bool { return true }, fns...)
+}
+
+func (e Error) Switch(cases ...SwitchCase) Error {
+	if e.err == nil {
+		return e
+	}
+
+	for _, c := range cases {
+		if c.match(e.err) {
+			return e.modify(nil).Chain(c.fns...)
+		}
+	}
+	return e
+}
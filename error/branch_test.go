@@ -0,0 +1,145 @@
+package error
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+)
+
+func yes() bool { return true }
+func no() bool  { return false }
+
+func TestWhenExecutesProvidedBlocksIfPredicateHolds(t *testing.T) {
+	executed := []string{}
+
+	e := When(yes,
+		func() error { executed = append(executed, "first"); return nil },
+		func() error { executed = append(executed, "second"); return nil },
+	)
+
+	assert.Equal(t, Return(nil), e)
+	assert.Equal(t, []string{"first", "second"}, executed)
+}
+
+func TestWhenSkipsProvidedBlocksUnlessPredicateHolds(t *testing.T) {
+	executed := false
+
+	e := When(no, func() error { executed = true; return nil })
+
+	assert.Equal(t, Return(nil), e)
+	assert.Equal(t, false, executed)
+}
+
+func TestWhenOnErrorDoesNotEvaluatePredicate(t *testing.T) {
+	evaluated := false
+	err := errors.New("Incompatible message version")
+
+	e := Return(err).When(func() bool { evaluated = true; return true })
+
+	assert.Equal(t, Return(err), e)
+	assert.Equal(t, false, evaluated)
+}
+
+func TestWhenShortCircuits(t *testing.T) {
+	err := errors.New("Unable to parse data")
+	executed := false
+
+	e := Return(nil).When(yes,
+		func() error { return err },
+		func() error { executed = true; return nil },
+	).Bind(func() error { executed = true; return nil })
+
+	assert.Equal(t, err, e.Err())
+	assert.Equal(t, false, executed)
+}
+
+func TestUnless(t *testing.T) {
+	executed := 0
+
+	Unless(yes, func() error { executed++; return nil })
+	Unless(no, func() error { executed++; return nil })
+
+	assert.Equal(t, 1, executed)
+}
+
+func TestIfElse(t *testing.T) {
+	branch := ""
+	thenFns := []func() error{func() error { branch = "then"; return nil }}
+	elseFns := []func() error{func() error { branch = "else"; return nil }}
+
+	IfElse(yes, thenFns, elseFns)
+	assert.Equal(t, "then", branch)
+
+	IfElse(no, thenFns, elseFns)
+	assert.Equal(t, "else", branch)
+}
+
+func TestBranchesPreserveDeferred(t *testing.T) {
+	executed := false
+
+	Return(nil).
+		Defer(func() { executed = true }).
+		When(yes, func() error { return errors.New("The error") }).
+		Err()
+
+	assert.Equal(t, true, executed)
+}
+
+func TestSwitchOnNoErrorReturnsSameValue(t *testing.T) {
+	executed := false
+
+	e := Return(nil).Switch(Default(func() error { executed = true; return nil }))
+
+	assert.Equal(t, Return(nil), e)
+	assert.Equal(t, false, executed)
+}
+
+func TestSwitchExecutesMatchingCase(t *testing.T) {
+	branch := ""
+	err := &os.PathError{Op: "open", Path: "config.json", Err: os.ErrNotExist}
+
+	e := Return(err).Switch(
+		Case(os.ErrPermission, func() error { branch = "permission"; return nil }),
+		Case(os.ErrNotExist, func() error { branch = "not exist"; return nil }),
+		Default(func() error { branch = "default"; return nil }),
+	)
+
+	assert.Equal(t, Return(nil), e)
+	assert.Equal(t, "not exist", branch)
+}
+
+func TestSwitchWithoutMatchingCaseReturnsSameError(t *testing.T) {
+	err := errors.New("Boring error message")
+
+	e := Return(err).Switch(Case(os.ErrNotExist, func() error { return nil }))
+
+	assert.Equal(t, Return(err), e)
+}
+
+func TestSwitchCaseFn(t *testing.T) {
+	err := &os.PathError{Op: "open", Path: "config.json", Err: os.ErrClosed}
+	var got string
+
+	Return(err).Switch(CaseFn(
+		func(err error) bool {
+			var pathErr *os.PathError
+			return errors.As(err, &pathErr)
+		},
+		func() error { got = err.Path; return nil },
+	))
+
+	assert.Equal(t, "config.json", got)
+}
+
+func TestSwitchPreservesDeferred(t *testing.T) {
+	executed := false
+
+	Return(nil).
+		Defer(func() { executed = true }).
+		Bind(func() error { return os.ErrNotExist }).
+		Switch(Case(os.ErrNotExist, func() error { return nil })).
+		Err()
+
+	assert.Equal(t, true, executed)
+}
@@ -0,0 +1,76 @@
+package error
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const DebugEnv = "MONAD_DEBUG"
+
+type debugWriter struct {
+	mutex sync.Mutex
+	out   io.Writer
+}
+
+var debugOutput atomic.Pointer[debugWriter]
+
+func init() {
+	if os.Getenv(DebugEnv) != "" {
+		Debug(os.Stderr)
+	}
+}
+
+func Debug(out io.Writer) {
+	if out == nil {
+		debugOutput.Store(nil)
+		return
+	}
+	debugOutput.Store(&debugWriter{out: out})
+}
+
+func debugging() bool {
+	return debugOutput.Load() != nil
+}
+
+func debugSkipped(name string, err error) {
+	if w := debugOutput.Load(); w != nil {
+		w.printf("step %s skipped: %v", name, err)
+	}
+}
+
+func debugDeferred(name string) {
+	if w := debugOutput.Load(); w != nil {
+		w.printf("deferred %s", name)
+	}
+}
+
+func debugCompensation(fn compensatingFunc) {
+	if w := debugOutput.Load(); w != nil {
+		w.printf("compensation %s", StepName(fn))
+	}
+}
+
+func debugStep(step Step, next func() error) error {
+	started := time.Now()
+	err := next()
+	duration := time.Since(started)
+
+	if w := debugOutput.Load(); w != nil {
+		if err != nil {
+			w.printf("step %s failed in %v: %v", step.Name, duration, err)
+		} else {
+			w.printf("step %s succeeded in %v", step.Name, duration)
+		}
+	}
+	return err
+}
+
+func (w *debugWriter) printf(format string, args ...interface{}) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	fmt.Fprintf(w.out, "monad: "+format+"\n", args...)
+}
@@ -0,0 +1,66 @@
+package error
+
+import (
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+var errDebug = errors.New("Connection refused")
+
+func debugConnect() error  { return nil }
+func debugFetch() error    { return errDebug }
+func debugPublish() error  { return nil }
+func debugClose()          {}
+func debugFlush()          {}
+func debugRollback() error { return nil }
+
+func TestDebugPrintsChainExecution(t *testing.T) {
+	out := &bytes.Buffer{}
+	Debug(out)
+	defer Debug(nil)
+
+	Bind(debugConnect).
+		Defer(debugClose).
+		Defer(debugFlush).
+		Compensate(debugRollback).
+		Chain(debugFetch, debugPublish).
+		Err()
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, 6, len(lines))
+	assert.Regexp(t, regexp.MustCompile(`^monad: step .*\.debugConnect succeeded in \S+$`), lines[0])
+	assert.Regexp(t, regexp.MustCompile(`^monad: step .*\.debugFetch failed in \S+: Connection refused$`), lines[1])
+	assert.Regexp(t, regexp.MustCompile(`^monad: step .*\.debugPublish skipped: Connection refused$`), lines[2])
+	assert.Regexp(t, regexp.MustCompile(`^monad: compensation .*\.debugRollback$`), lines[3])
+	assert.Regexp(t, regexp.MustCompile(`^monad: deferred .*\.debugClose$`), lines[4])
+	assert.Regexp(t, regexp.MustCompile(`^monad: deferred .*\.debugFlush$`), lines[5])
+}
+
+func TestDebugDoesNotPrintWhenDisabled(t *testing.T) {
+	out := &bytes.Buffer{}
+	Debug(out)
+	Debug(nil)
+
+	Chain(debugConnect, debugFetch, debugPublish).Err()
+
+	assert.Equal(t, "", out.String())
+	assert.Equal(t, false, intercepting(nil))
+}
+
+func TestDebugPrintsBranchSkippedAfterFailure(t *testing.T) {
+	out := &bytes.Buffer{}
+	Debug(out)
+	defer Debug(nil)
+
+	Bind(debugFetch).
+		When(func() bool { return true }, debugPublish).
+		Err()
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, 2, len(lines))
+	assert.Regexp(t, regexp.MustCompile(`^monad: step .*\.debugPublish skipped: Connection refused$`), lines[1])
+}
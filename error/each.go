@@ -0,0 +1,122 @@
+package error
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+type ItemError struct {
+	Index int
+	Item  interface{}
+	Err   error
+}
+
+type ItemsError struct {
+	Failures []ItemError
+}
+
+func ForEach[T any](items []T, fn func(T) Error) Error {
+	results := make([]*Error, len(items))
+	for i, item := range items {
+		result := fn(item)
+		results[i] = &result
+		if result.err != nil {
+			break
+		}
+	}
+	return collect(items, results)
+}
+
+func ForEachCollect[T any](items []T, fn func(T) Error) Error {
+	return ForEachParallel(items, 1, fn)
+}
+
+func ForEachParallel[T any](items []T, workers int, fn func(T) Error) Error {
+	if workers < 1 {
+		workers = 1
+	}
+
+	results := make([]*Error, len(items))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				result := fn(items[i])
+				results[i] = &result
+			}
+		}()
+	}
+
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return collect(items, results)
+}
+
+func (e Error) Then(fn func() Error) Error {
+	if e.err != nil {
+		return e
+	}
+	return e.merge(fn())
+}
+
+func collect[T any](items []T, results []*Error) Error {
+	e := Return(nil)
+	failures := []ItemError{}
+
+	for i, result := range results {
+		if result == nil {
+			continue
+		}
+
+		e = e.merge(*result)
+		if result.err != nil {
+			failures = append(failures, ItemError{i, items[i], result.err})
+		}
+	}
+
+	if len(failures) == 0 {
+		return e.modify(nil)
+	}
+	return e.modify(&ItemsError{failures})
+}
+
+func (e Error) merge(other Error) Error {
+	if other.inspection != e.inspection {
+		for range other.deferred {
+			e.inspection.recordDeferred()
+		}
+	}
+	e.deferred = append(append([]deferrableFunc{}, e.deferred...), other.deferred...)
+	e.compensations = append(append([]compensatingFunc(nil), e.compensations...), other.compensations...)
+	e.triggered = append(append([]compensatingFunc(nil), e.triggered...), other.triggered...)
+	return e.modify(other.err)
+}
+
+func (e *ItemsError) Error() string {
+	messages := make([]string, len(e.Failures))
+	for i, failure := range e.Failures {
+		messages[i] = failure.Error()
+	}
+	return fmt.Sprintf("%d item(s) failed: %s", len(e.Failures), strings.Join(messages, "; "))
+}
+
+func (e *ItemsError) Unwrap() []error {
+	errs := make([]error, len(e.Failures))
+	for i, failure := range e.Failures {
+		errs[i] = failure.Err
+	}
+	return errs
+}
+
+func (e ItemError) Error() string {
+	return fmt.Sprintf("item #%d (%v): %v", e.Index, e.Item, e.Err)
+}
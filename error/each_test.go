@@ -0,0 +1,158 @@
+package error
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+var errMissing = errors.New("File is missing")
+
+func copyFile(copied *[]string) func(string) Error {
+	return func(name string) Error {
+		if name == "missing.txt" {
+			return Return(errMissing)
+		}
+		*copied = append(*copied, name)
+		return Return(nil)
+	}
+}
+
+func TestForEachExecutesProvidedBlockForEachItem(t *testing.T) {
+	copied := []string{}
+
+	e := ForEach([]string{"a.txt", "b.txt"}, copyFile(&copied))
+
+	assert.Equal(t, Return(nil), e)
+	assert.Equal(t, []string{"a.txt", "b.txt"}, copied)
+}
+
+func TestForEachStopsAtFirstFailure(t *testing.T) {
+	copied := []string{}
+
+	err := ForEach([]string{"a.txt", "missing.txt", "b.txt"}, copyFile(&copied)).Err()
+
+	var itemsErr *ItemsError
+	assert.Equal(t, true, errors.As(err, &itemsErr))
+	assert.Equal(t, []ItemError{{1, "missing.txt", errMissing}}, itemsErr.Failures)
+	assert.Equal(t, []string{"a.txt"}, copied)
+	assert.Equal(t, true, errors.Is(err, errMissing))
+	assert.Equal(t, "1 item(s) failed: item #1 (missing.txt): File is missing", err.Error())
+}
+
+func TestForEachCollectContinuesAfterFailure(t *testing.T) {
+	copied := []string{}
+
+	err := ForEachCollect(
+		[]string{"missing.txt", "a.txt", "missing.txt"},
+		copyFile(&copied),
+	).Err()
+
+	var itemsErr *ItemsError
+	assert.Equal(t, true, errors.As(err, &itemsErr))
+	assert.Equal(t, []ItemError{
+		{0, "missing.txt", errMissing},
+		{2, "missing.txt", errMissing},
+	}, itemsErr.Failures)
+	assert.Equal(t, []string{"a.txt"}, copied)
+}
+
+func TestForEachParallelBoundsConcurrency(t *testing.T) {
+	var (
+		mutex    sync.Mutex
+		inFlight int
+		maximum  int
+		done     []int
+	)
+
+	e := ForEachParallel([]int{1, 2, 3, 4, 5, 6}, 2, func(x int) Error {
+		mutex.Lock()
+		inFlight++
+		if inFlight > maximum {
+			maximum = inFlight
+		}
+		mutex.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mutex.Lock()
+		inFlight--
+		done = append(done, x)
+		mutex.Unlock()
+		return Return(nil)
+	})
+
+	sort.Ints(done)
+	assert.Equal(t, nil, e.Err())
+	assert.Equal(t, 2, maximum)
+	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, done)
+}
+
+func TestForEachParallelReportsFailedItemsInOrder(t *testing.T) {
+	err := ForEachParallel([]int{1, 2, 3, 4}, 4, func(x int) Error {
+		if x%2 == 0 {
+			return Return(errMissing)
+		}
+		return Return(nil)
+	}).Err()
+
+	var itemsErr *ItemsError
+	assert.Equal(t, true, errors.As(err, &itemsErr))
+	assert.Equal(t, []ItemError{{1, 2, errMissing}, {3, 4, errMissing}}, itemsErr.Failures)
+}
+
+func TestForEachPreservesPerItemDeferred(t *testing.T) {
+	closed := []string{}
+
+	err := ForEach([]string{"a.txt", "b.txt", "missing.txt"}, func(name string) Error {
+		return Return(nil).
+			Defer(func() { closed = append(closed, name) }).
+			Bind(func() error {
+				if name == "missing.txt" {
+					return errMissing
+				}
+				return nil
+			})
+	}).Err()
+
+	assert.Equal(t, true, errors.Is(err, errMissing))
+	assert.Equal(t, []string{"a.txt", "b.txt", "missing.txt"}, closed)
+}
+
+func TestForEachDeferredIsNotExecutedUntilErr(t *testing.T) {
+	executed := false
+
+	ForEach([]int{1}, func(int) Error {
+		return Return(nil).Defer(func() { executed = true })
+	})
+
+	assert.Equal(t, false, executed)
+}
+
+func TestThenContinuesChainWithEachItem(t *testing.T) {
+	order := []string{}
+
+	Return(nil).
+		Defer(func() { order = append(order, "outer") }).
+		Then(func() Error {
+			return ForEach([]int{1, 2}, func(int) Error {
+				return Return(nil).Defer(func() { order = append(order, "item") })
+			})
+		}).
+		Err()
+
+	assert.Equal(t, []string{"outer", "item", "item"}, order)
+}
+
+func TestThenOnErrorDoesNotExecuteProvidedBlock(t *testing.T) {
+	executed := false
+	err := errors.New("The error")
+
+	e := Return(err).Then(func() Error { executed = true; return Return(nil) })
+
+	assert.Equal(t, Return(err), e)
+	assert.Equal(t, false, executed)
+}
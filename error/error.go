@@ -1,38 +1,58 @@
 package error
 
-import "errors"
+import (
+	"context"
+	"errors"
+)
 
 type failableFunc func() error
 type deferrableFunc func()
 type handlerFunc func(error)
+type compensatingFunc func() error
 
 type Error struct {
-	err      error
-	deferred []deferrableFunc
+	err           error
+	deferred      []deferrableFunc
+	compensations []compensatingFunc
+	interceptors  []Interceptor
+	inspection    *Inspection
+	triggered     []compensatingFunc
+	ctx           context.Context
 }
 
 var ErrorWasExpected = errors.New("Error was expected")
 
 func Return(value error) Error {
-	return Error{value, make([]deferrableFunc, 0)}
+	return Error{err: value, deferred: make([]deferrableFunc, 0)}
 }
 
 func Bind(fn failableFunc) Error {
-	return Return(fn())
+	return Return(nil).Bind(fn)
 }
 
-func Chain(fns ...failableFunc) Error {
+func BindContext(fn func(ctx context.Context) error) Error {
+	return Return(nil).BindContext(fn)
+}
+
+func Chain(fns ...func() error) Error {
 	return Return(nil).Chain(fns...)
 }
 
-func (e Error) Bind(fn failableFunc) Error {
-	if e.err != nil {
-		return e
+func Compose(fns ...func() error) func() error {
+	return func() error {
+		return Chain(fns...).Err()
 	}
-	return e.modify(fn())
 }
 
-func (e Error) Chain(fns ...failableFunc) (result Error) {
+func (e Error) Bind(fn failableFunc) Error {
+	return e.bind(nameOf(fn), func(context.Context) error { return fn() })
+}
+
+func (e Error) BindContext(fn func(ctx context.Context) error) Error {
+	return e.bind(nameOf(fn), fn)
+}
+
+func (e Error) Chain(fns ...func() error) (result Error) {
 	result = e
 	for _, fn := range fns {
 		result = result.Bind(fn)
@@ -44,12 +64,23 @@ func (e Error) Defer(fn deferrableFunc) Error {
 	if e.err != nil {
 		return e
 	}
-	return Error{e.err, append(e.deferred, fn)}
+	e.inspection.recordDeferred()
+	e.deferred = append(e.deferred, fn)
+	return e
+}
+
+func (e Error) Compensate(fn compensatingFunc) Error {
+	if e.err != nil {
+		return e
+	}
+	e.compensations = append(e.compensations, fn)
+	return e
 }
 
 func (e Error) Err() error {
+	err := e.resolveCompensations()
 	e.resolveDeferred()
-	return e.err
+	return err
 }
 
 func (e Error) OnError() Error {
@@ -68,10 +99,44 @@ func (e Error) OnErrorFn(fn handlerFunc) Error {
 
 func (e Error) resolveDeferred() {
 	for _, fn := range e.deferred {
-		fn()
+		RunDeferred(nameOf(fn), e.inspection, fn)
 	}
 }
 
+func (e Error) resolveCompensations() error {
+	errs := []error{e.err}
+	for i := len(e.triggered) - 1; i >= 0; i-- {
+		debugCompensation(e.triggered[i])
+		errs = append(errs, e.triggered[i]())
+	}
+	return join(errs...)
+}
+
+func (e Error) bind(name func() string, fn func(ctx context.Context) error) Error {
+	if e.err != nil {
+		e.skip(name)
+		return e
+	}
+
+	return e.modify(RunStep(e.context(), name, nil, e.interceptors, e.inspection, fn))
+}
+
+func (e Error) context() context.Context {
+	if e.ctx == nil {
+		return context.Background()
+	}
+	return e.ctx
+}
+
+func (e Error) skip(name func() string) {
+	SkipStep(name, e.err, e.inspection)
+}
+
 func (e Error) modify(err error) Error {
-	return Error{err, e.deferred}
+	if err != nil && len(e.compensations) > 0 {
+		e.triggered = append(append([]compensatingFunc(nil), e.triggered...), e.compensations...)
+		e.compensations = nil
+	}
+	e.err = err
+	return e
 }
@@ -29,13 +29,13 @@ func TestBindHelperReturnsWrappedErrorIfFails(t *testing.T) {
 
 func TestReturnWrapsNil(t *testing.T) {
 	e := Return(nil)
-	assert.Equal(t, Error{nil, make([]deferrableFunc, 0)}, e)
+	assert.Equal(t, Error{err: nil, deferred: make([]deferrableFunc, 0)}, e)
 }
 
 func TestReturnWrapsError(t *testing.T) {
 	err := errors.New("Something else have gone wrong")
 	e := Return(err)
-	assert.Equal(t, Error{err, make([]deferrableFunc, 0)}, e)
+	assert.Equal(t, Error{err: err, deferred: make([]deferrableFunc, 0)}, e)
 }
 
 func TestBindOnNoErrorExecutesProvidedBlock(t *testing.T) {
@@ -273,3 +273,122 @@ func TestChainCallsFunctionsUntilErrorOccurs(t *testing.T) {
 	assert.Equal(t, -1, executed_2)
 	assert.Equal(t, -1, executed_3)
 }
+
+func TestCompensateOnNoErrorDoesNotExecuteProvidedBlock(t *testing.T) {
+	executed := false
+	err := Return(nil).
+		Compensate(func() error { executed = true; return nil }).
+		Bind(func() error { return nil }).
+		Err()
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, executed)
+}
+
+func TestCompensateOnLaterErrorExecutesInReverseOrder(t *testing.T) {
+	undone := []string{}
+	err := errors.New("Unable to charge card")
+
+	got := Bind(func() error { return nil }).
+		Compensate(func() error { undone = append(undone, "order"); return nil }).
+		Bind(func() error { return nil }).
+		Compensate(func() error { undone = append(undone, "reservation"); return nil }).
+		Bind(func() error { return err }).
+		Compensate(func() error { undone = append(undone, "payment"); return nil }).
+		Err()
+
+	assert.Equal(t, err, got)
+	assert.Equal(t, []string{"reservation", "order"}, undone)
+}
+
+func TestCompensateIsNotExecutedUntilErr(t *testing.T) {
+	executed := false
+	Return(nil).
+		Compensate(func() error { executed = true; return nil }).
+		Bind(func() error { return errors.New("The error") })
+
+	assert.Equal(t, false, executed)
+}
+
+func TestCompensateRunsBeforeDeferred(t *testing.T) {
+	order := []string{}
+
+	Return(nil).
+		Defer(func() { order = append(order, "close connection") }).
+		Compensate(func() error { order = append(order, "delete record"); return nil }).
+		Bind(func() error { return errors.New("The error") }).
+		Err()
+
+	assert.Equal(t, []string{"delete record", "close connection"}, order)
+}
+
+func TestCompensationFailuresAreReportedWithOriginalError(t *testing.T) {
+	err := errors.New("Unable to copy file")
+	undoErr := errors.New("Unable to remove directory")
+
+	got := Chain(func() error { return nil }).
+		Compensate(func() error { return undoErr }).
+		Bind(func() error { return err }).
+		Err()
+
+	assert.Equal(t, true, errors.Is(got, err))
+	assert.Equal(t, true, errors.Is(got, undoErr))
+}
+
+func TestComposeDoesNotExecuteProvidedBlocksUntilCalled(t *testing.T) {
+	executed := false
+	Compose(func() error { executed = true; return nil })
+	assert.Equal(t, false, executed)
+}
+
+func TestComposeCallsFunctionsUntilErrorOccurs(t *testing.T) {
+	order := []string{}
+	err := errors.New("Very peculiar error")
+
+	step := Compose(
+		func() error { order = append(order, "fetch"); return nil },
+		func() error { order = append(order, "validate"); return err },
+		func() error { order = append(order, "copy"); return nil },
+	)
+
+	assert.Equal(t, err, Return(nil).Bind(step).Err())
+	assert.Equal(t, []string{"fetch", "validate"}, order)
+}
+
+func TestComposeCanBeNested(t *testing.T) {
+	order := []string{}
+	fetch := func() error { order = append(order, "fetch"); return nil }
+	validate := func() error { order = append(order, "validate"); return nil }
+	copy := func() error { order = append(order, "copy"); return nil }
+
+	install := Compose(Compose(fetch, validate), copy)
+	Chain(install, install)
+
+	assert.Equal(t, []string{"fetch", "validate", "copy", "fetch", "validate", "copy"}, order)
+}
+
+func TestCompensateIsExecutedWhenChainEndsWithOnErrorFn(t *testing.T) {
+	err := errors.New("Card declined")
+	order := []string{}
+
+	result := Bind(func() error { return nil }).
+		Compensate(func() error { order = append(order, "undo"); return nil }).
+		Bind(func() error { return err }).
+		OnErrorFn(func(err error) { order = append(order, "report") }).
+		Err()
+
+	assert.Equal(t, nil, result)
+	assert.Equal(t, []string{"report", "undo"}, order)
+}
+
+func TestCompensateErrorIsReportedWhenFailureWasHandled(t *testing.T) {
+	undoErr := errors.New("Unable to cancel order")
+
+	result := Bind(func() error { return nil }).
+		Compensate(func() error { return undoErr }).
+		Bind(func() error { return errors.New("Card declined") }).
+		OnErrorFn(func(error) {}).
+		Err()
+
+	assert.Equal(t, undoErr, result)
+}
@@ -0,0 +1,114 @@
+package error
+
+import "sync"
+
+type Inspection struct {
+	mutex    sync.Mutex
+	ran      []string
+	skipped  []string
+	failed   string
+	deferred int
+	executed int
+}
+
+func NewInspection() *Inspection {
+	return &Inspection{}
+}
+
+func Inspect(inspection *Inspection) Error {
+	return Return(nil).Inspect(inspection)
+}
+
+func (e Error) Inspect(inspection *Inspection) Error {
+	if inspection != e.inspection {
+		for range e.deferred {
+			inspection.recordDeferred()
+		}
+	}
+	e.inspection = inspection
+	return e
+}
+
+func (i *Inspection) Ran() []string {
+	if i == nil {
+		return nil
+	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return append([]string(nil), i.ran...)
+}
+
+func (i *Inspection) Skipped() []string {
+	if i == nil {
+		return nil
+	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return append([]string(nil), i.skipped...)
+}
+
+func (i *Inspection) Failed() string {
+	if i == nil {
+		return ""
+	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return i.failed
+}
+
+func (i *Inspection) DeferredPending() int {
+	if i == nil {
+		return 0
+	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return i.deferred - i.executed
+}
+
+func (i *Inspection) DeferredExecuted() int {
+	if i == nil {
+		return 0
+	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	return i.executed
+}
+
+func (i *Inspection) recordStep(name string, err error) {
+	if i == nil {
+		return
+	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.ran = append(i.ran, name)
+	if err != nil && i.failed == "" {
+		i.failed = name
+	}
+}
+
+func (i *Inspection) recordSkipped(name string) {
+	if i == nil {
+		return
+	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.skipped = append(i.skipped, name)
+}
+
+func (i *Inspection) recordDeferred() {
+	if i == nil {
+		return
+	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.deferred++
+}
+
+func (i *Inspection) recordDeferredRan() {
+	if i == nil {
+		return
+	}
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+	i.executed++
+}
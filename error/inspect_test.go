@@ -0,0 +1,91 @@
+package error
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var errCardDeclined = errors.New("Card declined")
+
+func reserveStock() error { return nil }
+func chargeCard() error   { return errCardDeclined }
+func shipOrder() error    { return nil }
+func emailReceipt() error { return nil }
+func unlockOrder()        {}
+
+func TestInspectRecordsChainExecution(t *testing.T) {
+	inspection := NewInspection()
+
+	e := Inspect(inspection).
+		Bind(reserveStock).
+		Defer(unlockOrder).
+		Chain(chargeCard, shipOrder, emailReceipt)
+
+	assert.Equal(t, []string{StepName(reserveStock), StepName(chargeCard)}, inspection.Ran())
+	assert.Equal(t, []string{StepName(shipOrder), StepName(emailReceipt)}, inspection.Skipped())
+	assert.Equal(t, StepName(chargeCard), inspection.Failed())
+	assert.Equal(t, 1, inspection.DeferredPending())
+	assert.Equal(t, 0, inspection.DeferredExecuted())
+
+	e.Err()
+
+	assert.Equal(t, 0, inspection.DeferredPending())
+	assert.Equal(t, 1, inspection.DeferredExecuted())
+}
+
+func TestInspectionOfSuccessfulChainHasNoFailedStep(t *testing.T) {
+	inspection := NewInspection()
+
+	Inspect(inspection).Chain(reserveStock, shipOrder).Err()
+
+	assert.Equal(t, 2, len(inspection.Ran()))
+	assert.Equal(t, []string(nil), inspection.Skipped())
+	assert.Equal(t, "", inspection.Failed())
+}
+
+func TestInspectionCountsDeferredOfMergedChains(t *testing.T) {
+	inspection := NewInspection()
+
+	e := Inspect(inspection).Then(func() Error {
+		return Bind(reserveStock).Defer(unlockOrder).Defer(unlockOrder)
+	})
+	assert.Equal(t, 2, inspection.DeferredPending())
+
+	e.Err()
+	assert.Equal(t, 2, inspection.DeferredExecuted())
+}
+
+func TestInspectionCountsDeferredAttachedBeforeInspect(t *testing.T) {
+	inspection := NewInspection()
+
+	e := Return(nil).Defer(unlockOrder).Inspect(inspection)
+	assert.Equal(t, 1, inspection.DeferredPending())
+
+	e.Err()
+	assert.Equal(t, 0, inspection.DeferredPending())
+	assert.Equal(t, 1, inspection.DeferredExecuted())
+}
+
+func TestInspectionRecordsBranchesSkippedAfterFailure(t *testing.T) {
+	inspection := NewInspection()
+	express := func() bool { return true }
+
+	Inspect(inspection).
+		Bind(chargeCard).
+		IfElse(express, []func() error{shipOrder}, []func() error{emailReceipt}).
+		Err()
+
+	assert.Equal(t, []string{StepName(chargeCard)}, inspection.Ran())
+	assert.Equal(t, []string{StepName(shipOrder), StepName(emailReceipt)}, inspection.Skipped())
+}
+
+func TestNilInspectionIsNoop(t *testing.T) {
+	var inspection *Inspection
+
+	Chain(reserveStock, chargeCard).Err()
+
+	assert.Equal(t, []string(nil), inspection.Ran())
+	assert.Equal(t, "", inspection.Failed())
+	assert.Equal(t, 0, inspection.DeferredPending())
+}
@@ -0,0 +1,92 @@
+package error
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+type Step struct {
+	Name  string
+	Input interface{}
+	ctx   *context.Context
+}
+
+type Interceptor func(step Step, next func() error) error
+
+var (
+	globalMutex        sync.Mutex
+	globalInterceptors atomic.Pointer[[]Interceptor]
+)
+
+func Use(fns ...Interceptor) {
+	globalMutex.Lock()
+	defer globalMutex.Unlock()
+
+	all := append(append([]Interceptor(nil), global()...), fns...)
+	globalInterceptors.Store(&all)
+}
+
+func ResetInterceptors() {
+	globalMutex.Lock()
+	defer globalMutex.Unlock()
+
+	globalInterceptors.Store(nil)
+}
+
+func Intercept(fns ...Interceptor) Error {
+	return Return(nil).Intercept(fns...)
+}
+
+func (e Error) Intercept(fns ...Interceptor) Error {
+	e.interceptors = append(append([]Interceptor(nil), e.interceptors...), fns...)
+	return e
+}
+
+func WithContext(ctx context.Context) Error {
+	return Return(nil).WithContext(ctx)
+}
+
+func (e Error) WithContext(ctx context.Context) Error {
+	e.ctx = ctx
+	return e
+}
+
+func intercepting(local []Interceptor) bool {
+	return len(local) > 0 || len(global()) > 0 || debugging()
+}
+
+func invoke(ctx context.Context, step Step, local []Interceptor, fn func(context.Context) error) error {
+	step.ctx = &ctx
+	next := func() error { return fn(*step.ctx) }
+
+	all := append(append([]Interceptor(nil), global()...), local...)
+	if debugging() {
+		all = append(all, debugStep)
+	}
+	for i := len(all) - 1; i >= 0; i-- {
+		fn, inner := all[i], next
+		next = func() error { return fn(step, inner) }
+	}
+	return next()
+}
+
+func (s Step) Context() context.Context {
+	if s.ctx == nil || *s.ctx == nil {
+		return context.Background()
+	}
+	return *s.ctx
+}
+
+func (s Step) SetContext(ctx context.Context) {
+	if s.ctx != nil {
+		*s.ctx = ctx
+	}
+}
+
+func global() []Interceptor {
+	if all := globalInterceptors.Load(); all != nil {
+		return *all
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package error
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func recording(label string, calls *[]string) Interceptor {
+	return func(step Step, next func() error) error {
+		*calls = append(*calls, label+" before")
+		err := next()
+		*calls = append(*calls, label+" after")
+		return err
+	}
+}
+
+func TestInterceptWrapsEveryStep(t *testing.T) {
+	err := errors.New("Connection refused")
+	steps := []string{}
+	outcomes := []error{}
+
+	result := Intercept(func(step Step, next func() error) error {
+		err := next()
+		steps = append(steps, step.Name)
+		outcomes = append(outcomes, err)
+		return err
+	}).Chain(
+		func() error { return nil },
+		func() error { return err },
+		func() error { return nil },
+	).Err()
+
+	assert.Equal(t, err, result)
+	assert.Equal(t, 2, len(steps))
+	assert.Equal(t, true, strings.HasSuffix(steps[0], ".func2"))
+	assert.Equal(t, []error{nil, err}, outcomes)
+}
+
+func TestInterceptorCanReplaceOutcome(t *testing.T) {
+	err := errors.New("Recovered from panic")
+
+	result := Intercept(func(step Step, next func() error) (outcome error) {
+		defer func() {
+			if recover() != nil {
+				outcome = err
+			}
+		}()
+		return next()
+	}).Bind(func() error {
+		panic("boom")
+	}).Err()
+
+	assert.Equal(t, err, result)
+}
+
+func TestInterceptorsRunInRegistrationOrder(t *testing.T) {
+	calls := []string{}
+	Use(recording("global", &calls))
+	defer ResetInterceptors()
+
+	Intercept(recording("first", &calls)).
+		Intercept(recording("second", &calls)).
+		Bind(func() error { calls = append(calls, "step"); return nil })
+
+	assert.Equal(t, []string{
+		"global before",
+		"first before",
+		"second before",
+		"step",
+		"second after",
+		"first after",
+		"global after",
+	}, calls)
+}
+
+func TestGlobalInterceptorsApplyToEveryChain(t *testing.T) {
+	calls := []string{}
+	Use(recording("global", &calls))
+	defer ResetInterceptors()
+
+	Bind(func() error { return nil })
+	Chain(func() error { return nil }, func() error { return nil })
+
+	assert.Equal(t, 6, len(calls))
+}
+
+func TestInterceptorsAreKeptThroughDeferAndCompensate(t *testing.T) {
+	calls := []string{}
+
+	Intercept(recording("local", &calls)).
+		Defer(func() {}).
+		Compensate(func() error { return nil }).
+		Bind(func() error { return nil })
+
+	assert.Equal(t, []string{"local before", "local after"}, calls)
+}
+
+func TestInterceptorsAreNotCalledWhenNoneRegistered(t *testing.T) {
+	assert.Equal(t, false, intercepting(nil))
+	assert.Equal(t, Return(nil), Bind(func() error { return nil }))
+}
+
+type tenantKey struct{}
+
+func TestBindContextReceivesChainContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), tenantKey{}, "acme")
+	tenants := []interface{}{}
+
+	WithContext(ctx).
+		BindContext(func(ctx context.Context) error {
+			tenants = append(tenants, ctx.Value(tenantKey{}))
+			return nil
+		}).
+		Intercept(recording("audit", &[]string{})).
+		BindContext(func(ctx context.Context) error {
+			tenants = append(tenants, ctx.Value(tenantKey{}))
+			return nil
+		})
+
+	assert.Equal(t, []interface{}{"acme", "acme"}, tenants)
+}
+
+func TestInterceptorPassesContextToInnerInterceptorsAndStep(t *testing.T) {
+	seen := []interface{}{}
+
+	Intercept(
+		func(step Step, next func() error) error {
+			step.SetContext(context.WithValue(step.Context(), tenantKey{}, "acme"))
+			return next()
+		},
+		func(step Step, next func() error) error {
+			seen = append(seen, step.Context().Value(tenantKey{}))
+			return next()
+		},
+	).BindContext(func(ctx context.Context) error {
+		seen = append(seen, ctx.Value(tenantKey{}))
+		return nil
+	})
+
+	assert.Equal(t, []interface{}{"acme", "acme"}, seen)
+}
+
+func TestStepWithoutContextUsesBackground(t *testing.T) {
+	step := Step{Name: "audit"}
+	step.SetContext(context.TODO())
+
+	assert.Equal(t, context.Background(), step.Context())
+}
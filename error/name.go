@@ -0,0 +1,23 @@
+package error
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+)
+
+func BindNamed(name string, fn func() error) Error {
+	return Return(nil).BindNamed(name, fn)
+}
+
+func (e Error) BindNamed(name string, fn func() error) Error {
+	return e.bind(func() string { return name }, func(context.Context) error { return fn() })
+}
+
+func StepName(fn interface{}) string {
+	return runtime.FuncForPC(reflect.ValueOf(fn).Pointer()).Name()
+}
+
+func nameOf(fn interface{}) func() string {
+	return func() string { return StepName(fn) }
+}
@@ -0,0 +1,41 @@
+package error
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func uploadReport() error { return nil }
+func notifyOwner() error  { return nil }
+
+func TestBindNamedReportsStepUnderItsName(t *testing.T) {
+	names := []string{}
+	inspection := NewInspection()
+
+	Intercept(func(step Step, next func() error) error {
+		names = append(names, step.Name)
+		return next()
+	}).
+		Inspect(inspection).
+		BindNamed("upload", uploadReport).
+		Bind(notifyOwner)
+
+	assert.Equal(t, []string{"upload", StepName(notifyOwner)}, names)
+	assert.Equal(t, []string{"upload", StepName(notifyOwner)}, inspection.Ran())
+}
+
+func TestBindNamedIsSkippedAfterFailure(t *testing.T) {
+	inspection := NewInspection()
+	executed := false
+
+	Inspect(inspection).
+		Bind(func() error { return ErrorWasExpected }).
+		BindNamed("upload", func() error { executed = true; return nil })
+
+	assert.Equal(t, false, executed)
+	assert.Equal(t, []string{"upload"}, inspection.Skipped())
+}
+
+func TestStepNameIsFunctionName(t *testing.T) {
+	assert.Equal(t, "github.com/nanoservice/monad.go/error.uploadReport", StepName(uploadReport))
+}
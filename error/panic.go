@@ -0,0 +1,39 @@
+package error
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("Recovered from panic: %v", p.Value)
+}
+
+func (p *PanicError) Unwrap() error {
+	err, _ := p.Value.(error)
+	return err
+}
+
+func Try(fn failableFunc) Error {
+	return Bind(Recover(fn))
+}
+
+func (e Error) Try(fn failableFunc) Error {
+	return e.Bind(Recover(fn))
+}
+
+func Recover(fn func() error) func() error {
+	return func() (err error) {
+		defer func() {
+			if value := recover(); value != nil {
+				err = &PanicError{value, debug.Stack()}
+			}
+		}()
+		return fn()
+	}
+}
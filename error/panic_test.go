@@ -0,0 +1,50 @@
+package error
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestTryHelperReturnsPanicAsError(t *testing.T) {
+	err := Try(func() error { panic("Out of cheese") }).Err()
+
+	var panicErr *PanicError
+	assert.Equal(t, true, errors.As(err, &panicErr))
+	assert.Equal(t, "Out of cheese", panicErr.Value)
+	assert.Equal(t, "Recovered from panic: Out of cheese", err.Error())
+	assert.Equal(t, true, strings.Contains(string(panicErr.Stack), "TestTryHelperReturnsPanicAsError"))
+}
+
+func TestTryHelperReturnsErrorIfNoPanic(t *testing.T) {
+	err := errors.New("Something gone wrong")
+	assert.Equal(t, Return(err), Try(func() error { return err }))
+	assert.Equal(t, Return(nil), Try(func() error { return nil }))
+}
+
+func TestTryOnErrorDoesNotExecuteProvidedBlock(t *testing.T) {
+	executed := false
+	err := errors.New("Incompatible message version")
+	e := Return(err).Try(func() error { executed = true; panic("boom") })
+
+	assert.Equal(t, false, executed)
+	assert.Equal(t, err, e.Err())
+}
+
+func TestTryKeepsDeferred(t *testing.T) {
+	executed := false
+	Return(nil).
+		Defer(func() { executed = true }).
+		Try(func() error { panic("boom") }).
+		Err()
+
+	assert.Equal(t, true, executed)
+}
+
+func TestRecoverUnwrapsPanicWithError(t *testing.T) {
+	err := errors.New("Unable to parse data")
+	got := Chain(Recover(func() error { panic(err) })).Err()
+
+	assert.Equal(t, true, errors.Is(got, err))
+}
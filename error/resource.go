@@ -0,0 +1,63 @@
+package error
+
+import "errors"
+
+type releaseFunc func() error
+
+type Resource struct {
+	acquisitions []acquisition
+}
+
+type acquisition struct {
+	open    failableFunc
+	release releaseFunc
+}
+
+func Acquire(open failableFunc, release releaseFunc) Resource {
+	return Resource{}.Acquire(open, release)
+}
+
+func (r Resource) Acquire(open failableFunc, release releaseFunc) Resource {
+	acquisitions := make([]acquisition, 0, len(r.acquisitions)+1)
+	acquisitions = append(acquisitions, r.acquisitions...)
+	return Resource{append(acquisitions, acquisition{open, release})}
+}
+
+func (r Resource) Use(fn failableFunc) Error {
+	return Return(r.use(fn))
+}
+
+func (r Resource) use(fn failableFunc) (err error) {
+	acquired := make([]releaseFunc, 0, len(r.acquisitions))
+
+	defer func() {
+		errs := []error{err}
+		for i := len(acquired) - 1; i >= 0; i-- {
+			errs = append(errs, acquired[i]())
+		}
+		err = join(errs...)
+	}()
+
+	for _, a := range r.acquisitions {
+		if err = a.open(); err != nil {
+			return
+		}
+		acquired = append(acquired, a.release)
+	}
+
+	return fn()
+}
+
+func join(errs ...error) error {
+	failed := make([]error, 0, len(errs))
+	for _, err := range errs {
+		if err != nil {
+			failed = append(failed, err)
+		}
+	}
+
+	if len(failed) == 1 {
+		return failed[0]
+	}
+	return errors.Join(failed...)
+}
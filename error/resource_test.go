@@ -0,0 +1,100 @@
+package error
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type fakeResource struct {
+	name     string
+	log      *[]string
+	closeErr error
+}
+
+func (r fakeResource) open() error {
+	*r.log = append(*r.log, "open "+r.name)
+	return nil
+}
+
+func (r fakeResource) close() error {
+	*r.log = append(*r.log, "close "+r.name)
+	return r.closeErr
+}
+
+func TestUseReleasesResourceAfterUse(t *testing.T) {
+	log := []string{}
+	file := fakeResource{"file", &log, nil}
+
+	e := Acquire(file.open, file.close).Use(func() error {
+		log = append(log, "use")
+		return nil
+	})
+
+	assert.Equal(t, Return(nil), e)
+	assert.Equal(t, []string{"open file", "use", "close file"}, log)
+}
+
+func TestUseReleasesInReverseOrder(t *testing.T) {
+	log := []string{}
+	file := fakeResource{"file", &log, nil}
+	conn := fakeResource{"conn", &log, nil}
+
+	Acquire(file.open, file.close).
+		Acquire(conn.open, conn.close).
+		Use(func() error { return nil })
+
+	assert.Equal(t, []string{"open file", "open conn", "close conn", "close file"}, log)
+}
+
+func TestUseReleasesOnlyAcquiredWhenOpenFails(t *testing.T) {
+	log := []string{}
+	file := fakeResource{"file", &log, nil}
+	err := errors.New("Connection refused")
+	executed := false
+
+	e := Acquire(file.open, file.close).
+		Acquire(func() error { return err }, func() error { log = append(log, "close conn"); return nil }).
+		Use(func() error { executed = true; return nil })
+
+	assert.Equal(t, err, e.Err())
+	assert.Equal(t, false, executed)
+	assert.Equal(t, []string{"open file", "close file"}, log)
+}
+
+func TestUseReportsReleaseErrorsTogetherWithUseError(t *testing.T) {
+	log := []string{}
+	closeErr := errors.New("Unable to flush file")
+	useErr := errors.New("Unable to write file")
+	file := fakeResource{"file", &log, closeErr}
+
+	err := Acquire(file.open, file.close).
+		Use(func() error { return useErr }).
+		Err()
+
+	assert.Equal(t, true, errors.Is(err, useErr))
+	assert.Equal(t, true, errors.Is(err, closeErr))
+}
+
+func TestUseReleasesOnPanic(t *testing.T) {
+	log := []string{}
+	file := fakeResource{"file", &log, nil}
+
+	assert.Panics(t, func() {
+		Acquire(file.open, file.close).Use(func() error { panic("boom") })
+	})
+	assert.Equal(t, []string{"open file", "close file"}, log)
+}
+
+func TestUseWithRecoverTurnsPanicIntoError(t *testing.T) {
+	log := []string{}
+	file := fakeResource{"file", &log, nil}
+
+	err := Acquire(file.open, file.close).
+		Use(Recover(func() error { panic("boom") })).
+		Err()
+
+	var panicErr *PanicError
+	assert.Equal(t, true, errors.As(err, &panicErr))
+	assert.Equal(t, []string{"open file", "close file"}, log)
+}
@@ -0,0 +1,87 @@
+package error
+
+import (
+	"fmt"
+	"sync"
+)
+
+type Key[T any] struct {
+	id *keyID
+}
+
+type keyID struct {
+	name string
+}
+
+type Scope struct {
+	mutex  sync.Mutex
+	values map[*keyID]interface{}
+}
+
+type MissingKeyError struct {
+	Key string
+}
+
+func NewKey[T any](name string) Key[T] {
+	return Key[T]{&keyID{name}}
+}
+
+func (k Key[T]) Name() string {
+	return k.id.name
+}
+
+func NewScope() *Scope {
+	return &Scope{values: map[*keyID]interface{}{}}
+}
+
+func Set[T any](s *Scope, key Key[T], fn func() (T, error)) func() error {
+	return func() error {
+		value, err := fn()
+		if err != nil {
+			return err
+		}
+
+		Put(s, key, value)
+		return nil
+	}
+}
+
+func With[T any](s *Scope, key Key[T], fn func(T) error) func() error {
+	return func() error {
+		value, err := Get(s, key)
+		if err != nil {
+			return err
+		}
+		return fn(value)
+	}
+}
+
+func DeferWith[T any](s *Scope, key Key[T], fn func(T)) func() {
+	return func() {
+		if value, err := Get(s, key); err == nil {
+			fn(value)
+		}
+	}
+}
+
+func Put[T any](s *Scope, key Key[T], value T) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.values[key.id] = value
+}
+
+func Get[T any](s *Scope, key Key[T]) (value T, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.values[key.id]
+	if !ok {
+		return value, &MissingKeyError{key.Name()}
+	}
+	value, _ = stored.(T)
+	return value, nil
+}
+
+func (e *MissingKeyError) Error() string {
+	return fmt.Sprintf("Key %s is missing in scope", e.Key)
+}
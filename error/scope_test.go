@@ -0,0 +1,97 @@
+package error
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type resource struct {
+	closed bool
+}
+
+var (
+	resourceKey = NewKey[*resource]("resource")
+	statusKey   = NewKey[string]("status")
+)
+
+func TestSetAndWith(t *testing.T) {
+	s := NewScope()
+	var got string
+
+	err := Chain(
+		Set(s, resourceKey, func() (*resource, error) { return &resource{}, nil }),
+		Set(s, statusKey, func() (string, error) { return "ready", nil }),
+		With(s, statusKey, func(status string) error { got = status; return nil }),
+	).Err()
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "ready", got)
+}
+
+func TestSetOnErrorFailsChainAndDoesNotStoreValue(t *testing.T) {
+	s := NewScope()
+	err := errors.New("Unable to connect to server")
+
+	got := Chain(
+		Set(s, statusKey, func() (string, error) { return "ignored", err }),
+	).Err()
+
+	_, missing := Get(s, statusKey)
+	assert.Equal(t, err, got)
+	assert.Equal(t, &MissingKeyError{"status"}, missing)
+}
+
+func TestWithMissingKeyFailsChain(t *testing.T) {
+	s := NewScope()
+	executed := false
+
+	err := Chain(
+		With(s, statusKey, func(string) error { executed = true; return nil }),
+	).Err()
+
+	var missing *MissingKeyError
+	assert.Equal(t, true, errors.As(err, &missing))
+	assert.Equal(t, "status", missing.Key)
+	assert.Equal(t, "Key status is missing in scope", err.Error())
+	assert.Equal(t, false, executed)
+}
+
+func TestKeysWithSameNameAreDistinct(t *testing.T) {
+	s := NewScope()
+	other := NewKey[string]("status")
+	Put(s, statusKey, "ready")
+
+	_, err := Get(s, other)
+
+	assert.Equal(t, &MissingKeyError{"status"}, err)
+}
+
+func TestGetReturnsStoredNilInterface(t *testing.T) {
+	s := NewScope()
+	lastErr := NewKey[error]("last error")
+	Put(s, lastErr, nil)
+
+	value, err := Get(s, lastErr)
+
+	assert.Equal(t, nil, value)
+	assert.Equal(t, nil, err)
+}
+
+func TestDeferWith(t *testing.T) {
+	s := NewScope()
+	var opened *resource
+
+	Chain(
+		Set(s, resourceKey, func() (*resource, error) {
+			opened = &resource{}
+			return opened, nil
+		}),
+	).Defer(
+		DeferWith(s, resourceKey, func(r *resource) { r.closed = true }),
+	).Bind(
+		With(s, statusKey, func(string) error { return nil }),
+	).Err()
+
+	assert.Equal(t, true, opened.closed)
+}
@@ -0,0 +1,38 @@
+package error
+
+import "context"
+
+func RunStep(ctx context.Context, name func() string, input interface{}, local []Interceptor, inspection *Inspection, fn func(context.Context) error) error {
+	var err error
+	if !intercepting(local) {
+		err = fn(ctx)
+	} else {
+		err = invoke(ctx, Step{Name: name(), Input: input}, local, fn)
+	}
+	if inspection != nil {
+		inspection.recordStep(name(), err)
+	}
+	return err
+}
+
+func SkipStep(name func() string, err error, inspection *Inspection) {
+	if debugging() || inspection != nil {
+		step := name()
+		debugSkipped(step, err)
+		inspection.recordSkipped(step)
+	}
+}
+
+func AddDeferred(inspection *Inspection, count int) {
+	for i := 0; i < count; i++ {
+		inspection.recordDeferred()
+	}
+}
+
+func RunDeferred(name func() string, inspection *Inspection, fn func()) {
+	if debugging() {
+		debugDeferred(name())
+	}
+	fn()
+	inspection.recordDeferredRan()
+}
@@ -0,0 +1,121 @@
+package error
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type TimeoutError struct {
+	Step    string
+	Timeout time.Duration
+}
+
+type Budget struct {
+	mutex     sync.Mutex
+	total     time.Duration
+	deadline  time.Time
+	remaining int
+}
+
+func (t *TimeoutError) Error() string {
+	return fmt.Sprintf("Step %s timed out after %v", t.Step, t.Timeout)
+}
+
+func (t *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+func WithTimeout(d time.Duration, fn func() error) func() error {
+	return Timeout(StepName(fn), d, fn)
+}
+
+func WithTimeoutContext(ctx context.Context, d time.Duration, fn func(context.Context) error) func() error {
+	return TimeoutContext(ctx, StepName(fn), d, fn)
+}
+
+func WithBudget(d time.Duration, fns ...func() error) func() error {
+	return func() error {
+		budget := NewBudget(d, len(fns))
+		e := Return(nil)
+		for _, fn := range fns {
+			e = e.Bind(budget.Timeout(StepName(fn), fn))
+		}
+		return e.Err()
+	}
+}
+
+func WithBudgetContext(ctx context.Context, d time.Duration, fns ...func(context.Context) error) func() error {
+	return func() error {
+		budget := NewBudget(d, len(fns))
+		e := Return(nil)
+		for _, fn := range fns {
+			e = e.Bind(budget.TimeoutContext(ctx, StepName(fn), fn))
+		}
+		return e.Err()
+	}
+}
+
+func Timeout(step string, d time.Duration, fn func() error) func() error {
+	return TimeoutContext(context.Background(), step, d, func(context.Context) error {
+		return fn()
+	})
+}
+
+func TimeoutContext(ctx context.Context, step string, d time.Duration, fn func(context.Context) error) func() error {
+	return func() error {
+		if d <= 0 {
+			return &TimeoutError{step, max(d, 0)}
+		}
+
+		stepCtx, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+
+		done := make(chan error, 1)
+		go func() { done <- fn(stepCtx) }()
+
+		select {
+		case err := <-done:
+			return err
+		case <-stepCtx.Done():
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			return &TimeoutError{step, d}
+		}
+	}
+}
+
+func NewBudget(d time.Duration, steps int) *Budget {
+	return &Budget{total: d, remaining: steps}
+}
+
+func (b *Budget) Timeout(step string, fn func() error) func() error {
+	return func() error {
+		return Timeout(step, b.Share(), fn)()
+	}
+}
+
+func (b *Budget) TimeoutContext(ctx context.Context, step string, fn func(context.Context) error) func() error {
+	return func() error {
+		return TimeoutContext(ctx, step, b.Share(), fn)()
+	}
+}
+
+func (b *Budget) Share() time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.deadline.IsZero() {
+		b.deadline = time.Now().Add(b.total)
+	}
+
+	steps := b.remaining
+	if steps < 1 {
+		steps = 1
+	}
+	b.remaining--
+
+	return max(time.Until(b.deadline)/time.Duration(steps), 0)
+}
@@ -0,0 +1,151 @@
+package error
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func fetchSlowly(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestWithTimeoutReturnsResultOfFastStep(t *testing.T) {
+	err := errors.New("Something gone wrong")
+
+	assert.Equal(t, nil, Bind(WithTimeout(time.Second, func() error { return nil })).Err())
+	assert.Equal(t, err, Bind(WithTimeout(time.Second, func() error { return err })).Err())
+}
+
+func TestWithTimeoutFailsWithTypedErrorNamingTheStep(t *testing.T) {
+	err := Bind(WithTimeoutContext(context.Background(), 10*time.Millisecond, fetchSlowly)).Err()
+
+	var timeoutErr *TimeoutError
+	assert.Equal(t, true, errors.As(err, &timeoutErr))
+	assert.Equal(t, "github.com/nanoservice/monad.go/error.fetchSlowly", timeoutErr.Step)
+	assert.Equal(t, 10*time.Millisecond, timeoutErr.Timeout)
+	assert.Equal(t, true, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestTimeoutUsesProvidedName(t *testing.T) {
+	err := Bind(TimeoutContext(context.Background(), "fetch template", time.Millisecond, fetchSlowly)).Err()
+
+	assert.Equal(t, "Step fetch template timed out after 1ms", err.Error())
+}
+
+func TestWithBudgetSplitsRemainingTimeAcrossSteps(t *testing.T) {
+	step := func() error { return nil }
+
+	budget := NewBudget(300*time.Millisecond, 3)
+	err := Chain(
+		budget.Timeout("first", step),
+		func() error { time.Sleep(100 * time.Millisecond); return nil },
+		budget.TimeoutContext(context.Background(), "second", fetchSlowly),
+		budget.Timeout("third", step),
+	).Err()
+
+	var timeoutErr *TimeoutError
+	assert.Equal(t, true, errors.As(err, &timeoutErr))
+	assert.Equal(t, "second", timeoutErr.Step)
+	assert.InDelta(t, 100*time.Millisecond, timeoutErr.Timeout, float64(20*time.Millisecond))
+}
+
+func TestWithBudgetPassesOnSuccess(t *testing.T) {
+	executed := 0
+	step := func() error { executed++; return nil }
+
+	err := Bind(WithBudget(time.Second, step, step, step)).Err()
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 3, executed)
+}
+
+func TestWithBudgetFailsWhenBudgetIsExhausted(t *testing.T) {
+	err := Bind(WithBudget(
+		20*time.Millisecond,
+		func() error { time.Sleep(30 * time.Millisecond); return nil },
+		func() error { return nil },
+	)).Err()
+
+	var timeoutErr *TimeoutError
+	assert.Equal(t, true, errors.As(err, &timeoutErr))
+}
+
+func TestWithBudgetStartsOverOnEveryRun(t *testing.T) {
+	step := WithBudget(
+		200*time.Millisecond,
+		func() error { time.Sleep(10 * time.Millisecond); return nil },
+		func() error { return nil },
+	)
+
+	assert.Equal(t, nil, Bind(step).Err())
+	time.Sleep(250 * time.Millisecond)
+	assert.Equal(t, nil, Bind(step).Err())
+}
+
+func TestWithBudgetGivesEveryRunItsOwnBudget(t *testing.T) {
+	shares := make(chan time.Duration, 2)
+	step := WithBudgetContext(
+		context.Background(),
+		time.Second,
+		func(context.Context) error { time.Sleep(300 * time.Millisecond); return nil },
+		func(ctx context.Context) error {
+			deadline, _ := ctx.Deadline()
+			shares <- time.Until(deadline)
+			return nil
+		},
+	)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			Bind(step)
+		}()
+		time.Sleep(150 * time.Millisecond)
+	}
+	wg.Wait()
+	close(shares)
+
+	for share := range shares {
+		assert.InDelta(t, 700*time.Millisecond, share, float64(75*time.Millisecond))
+	}
+}
+
+func TestWithTimeoutContextCancelsSlowStep(t *testing.T) {
+	cancelled := make(chan error, 1)
+
+	err := Bind(WithTimeoutContext(context.Background(), 10*time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+		cancelled <- ctx.Err()
+		return ctx.Err()
+	})).Err()
+
+	var timeoutErr *TimeoutError
+	assert.Equal(t, true, errors.As(err, &timeoutErr))
+	assert.Equal(t, context.DeadlineExceeded, <-cancelled)
+}
+
+func TestTimeoutContextReturnsErrorOfCancelledParent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := Bind(TimeoutContext(ctx, "fetch", time.Second, func(ctx context.Context) error {
+		<-ctx.Done()
+		time.Sleep(10 * time.Millisecond)
+		return nil
+	})).Err()
+
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestTimeoutErrorNeverReportsNegativeTimeout(t *testing.T) {
+	err := Bind(TimeoutContext(context.Background(), "fetch", -time.Second, fetchSlowly)).Err()
+
+	assert.Equal(t, "Step fetch timed out after 0s", err.Error())
+}
@@ -0,0 +1 @@
+future.go.t
\ No newline at end of file
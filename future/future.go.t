@@ -0,0 +1,259 @@
+// Code generated by github.com/nanoservice/monad.go/future
+// future monad
+// type: {{T}}
+package future_{{t}}
+
+import (
+        "context"
+        "errors"
+        "sync"
+        "time"
+        {{I}}
+)
+
+type handler      func({{T}}) Future
+type errorHandler func(error)
+type producer     func() ({{T}}, error)
+
+type Future struct {
+        state *state
+}
+
+type Promise struct {
+        future Future
+}
+
+type state struct {
+        mutex     sync.Mutex
+        done      chan struct{}
+        value     {{T}}
+        err       error
+        callbacks []func()
+}
+
+var (
+        ErrTimeout   = errors.New("Future timed out")
+        ErrNoFutures = errors.New("No futures were provided")
+)
+
+func NewPromise() Promise {
+        return Promise{pending()}
+}
+
+func (p Promise) Future() Future {
+        return p.future
+}
+
+func (p Promise) Resolve(value {{T}}) bool {
+        return p.future.complete(value, nil)
+}
+
+func (p Promise) Reject(err error) bool {
+        var zero {{T}}
+        return p.future.complete(zero, err)
+}
+
+func Async(fn producer) Future {
+        f := pending()
+        go func() {
+                f.complete(fn())
+        }()
+        return f
+}
+
+func Success(value {{T}}) Future {
+        f := pending()
+        f.complete(value, nil)
+        return f
+}
+
+func Failure(err error) Future {
+        var zero {{T}}
+        f := pending()
+        f.complete(zero, err)
+        return f
+}
+
+func (f Future) Bind(fn handler) Future {
+        next := pending()
+        f.onComplete(func() {
+                if f.state.err != nil {
+                        f.forward(next)
+                        return
+                }
+
+                fn(f.state.value).forwardOnComplete(next)
+        })
+        return next
+}
+
+func (f Future) Chain(fns... handler) Future {
+        for _, fn := range fns {
+                f = f.Bind(fn)
+        }
+        return f
+}
+
+func (f Future) OnErrorFn(fn errorHandler) Future {
+        f.onComplete(func() {
+                if f.state.err != nil {
+                        fn(f.state.err)
+                }
+        })
+        return f
+}
+
+func (f Future) Timeout(d time.Duration) Future {
+        var zero {{T}}
+        next := pending()
+        timer := time.AfterFunc(d, func() {
+                next.complete(zero, ErrTimeout)
+        })
+        f.onComplete(func() {
+                timer.Stop()
+                f.forward(next)
+        })
+        return next
+}
+
+func (f Future) Await(ctx context.Context) ({{T}}, error) {
+        select {
+        case <-f.state.done:
+                return f.state.value, f.state.err
+        case <-ctx.Done():
+                var zero {{T}}
+                return zero, ctx.Err()
+        }
+}
+
+func All(ctx context.Context, fs... Future) ([]{{T}}, error) {
+        failed := pending()
+        for _, f := range fs {
+                f.forwardFailureOnComplete(failed)
+        }
+
+        values := make([]{{T}}, len(fs))
+        for i, f := range fs {
+                select {
+                case <-f.state.done:
+                case <-failed.state.done:
+                        return nil, failed.state.err
+                case <-ctx.Done():
+                        return nil, ctx.Err()
+                }
+
+                if f.state.err != nil {
+                        return nil, f.state.err
+                }
+                values[i] = f.state.value
+        }
+        return values, nil
+}
+
+func Any(fs... Future) Future {
+        if len(fs) == 0 {
+                return Failure(ErrNoFutures)
+        }
+
+        var (
+                zero  {{T}}
+                mutex sync.Mutex
+                errs  = make([]error, 0, len(fs))
+        )
+
+        next := pending()
+        for _, f := range fs {
+                f.onCompleteWith(func(value {{T}}, err error) {
+                        if err == nil {
+                                next.complete(value, nil)
+                                return
+                        }
+
+                        mutex.Lock()
+                        errs = append(errs, err)
+                        failed := len(errs) == len(fs)
+                        mutex.Unlock()
+
+                        if failed {
+                                next.complete(zero, errors.Join(errs...))
+                        }
+                })
+        }
+        return next
+}
+
+func Race(fs... Future) Future {
+        if len(fs) == 0 {
+                return Failure(ErrNoFutures)
+        }
+
+        next := pending()
+        for _, f := range fs {
+                f.forwardOnComplete(next)
+        }
+        return next
+}
+
+func (f Future) complete(value {{T}}, err error) bool {
+        s := f.state
+        s.mutex.Lock()
+        select {
+        case <-s.done:
+                s.mutex.Unlock()
+                return false
+        default:
+        }
+
+        s.value, s.err = value, err
+        close(s.done)
+        callbacks := s.callbacks
+        s.callbacks = nil
+        s.mutex.Unlock()
+
+        for _, fn := range callbacks {
+                fn()
+        }
+        return true
+}
+
+func (f Future) onComplete(fn func()) {
+        s := f.state
+        s.mutex.Lock()
+        select {
+        case <-s.done:
+                s.mutex.Unlock()
+                fn()
+                return
+        default:
+        }
+
+        s.callbacks = append(s.callbacks, fn)
+        s.mutex.Unlock()
+}
+
+func (f Future) onCompleteWith(fn func({{T}}, error)) {
+        f.onComplete(func() { fn(f.state.value, f.state.err) })
+}
+
+func (f Future) forward(next Future) {
+        next.complete(f.state.value, f.state.err)
+}
+
+func (f Future) forwardOnComplete(next Future) {
+        f.onComplete(func() { f.forward(next) })
+}
+
+func (f Future) forwardFailureOnComplete(next Future) {
+        f.onComplete(func() {
+                if f.state.err != nil {
+                        f.forward(next)
+                }
+        })
+}
+
+func pending() Future {
+        return Future{&state{
+                done:      make(chan struct{}),
+                callbacks: []func(){},
+        }}
+}
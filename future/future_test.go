@@ -0,0 +1,189 @@
+//go:generate nanotemplate -T string --input=_future.tt.go
+//go:generate nanotemplate -T int --input=_future.tt.go
+package future
+
+import (
+	"context"
+	"errors"
+	"github.com/nanoservice/monad.go/future/future_int"
+	"github.com/nanoservice/monad.go/future/future_string"
+	"github.com/nanoservice/monad.go/result/result_int"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestAsyncBind(t *testing.T) {
+	f := future_int.Async(func() (int, error) {
+		return 7, nil
+	}).Bind(func(x int) future_int.Future {
+		return future_int.Success(x + 2)
+	})
+
+	value, err := f.Await(context.Background())
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 9, value)
+}
+
+func TestBindOnFailureDoesNotCallHandler(t *testing.T) {
+	executed := false
+	err := errors.New("The error")
+
+	_, got := future_string.Failure(err).Bind(func(_ string) future_string.Future {
+		executed = true
+		return future_string.Success("hello")
+	}).Await(context.Background())
+
+	assert.Equal(t, false, executed)
+	assert.Equal(t, err, got)
+}
+
+func TestBindIsScheduledUntilValueArrives(t *testing.T) {
+	p := future_string.NewPromise()
+	executed := make(chan string, 1)
+
+	f := p.Future().Bind(func(name string) future_string.Future {
+		executed <- name
+		return future_string.Success("hello, " + name)
+	})
+
+	assert.Equal(t, 0, len(executed))
+
+	p.Resolve("world")
+	value, err := f.Await(context.Background())
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "hello, world", value)
+	assert.Equal(t, "world", <-executed)
+}
+
+func TestChainStopsAtFirstFailure(t *testing.T) {
+	err := errors.New("The error")
+	executed := false
+
+	_, got := future_int.Success(15).Chain(
+		func(x int) future_int.Future {
+			return future_int.Async(func() (int, error) { return x + 2, nil })
+		},
+		func(_ int) future_int.Future {
+			return future_int.Failure(err)
+		},
+		func(x int) future_int.Future {
+			executed = true
+			return future_int.Success(x)
+		},
+	).Await(context.Background())
+
+	assert.Equal(t, err, got)
+	assert.Equal(t, false, executed)
+}
+
+func TestOnErrorFn(t *testing.T) {
+	err := errors.New("The error")
+	got := make(chan error, 1)
+
+	future_int.Failure(err).OnErrorFn(func(e error) { got <- e })
+
+	assert.Equal(t, err, <-got)
+}
+
+func TestPromiseCompletesOnlyOnce(t *testing.T) {
+	p := future_int.NewPromise()
+
+	assert.Equal(t, true, p.Resolve(1))
+	assert.Equal(t, false, p.Resolve(2))
+	assert.Equal(t, false, p.Reject(errors.New("The error")))
+
+	value, err := p.Future().Await(context.Background())
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, value)
+}
+
+func TestAwaitRespectsContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := future_int.NewPromise().Future().Await(ctx)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestTimeout(t *testing.T) {
+	_, err := future_int.NewPromise().Future().
+		Timeout(10 * time.Millisecond).
+		Await(context.Background())
+	assert.Equal(t, future_int.ErrTimeout, err)
+
+	value, err := future_int.Success(5).
+		Timeout(time.Second).
+		Await(context.Background())
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 5, value)
+}
+
+func TestAll(t *testing.T) {
+	values, err := future_int.All(
+		context.Background(),
+		future_int.Async(func() (int, error) { return 1, nil }),
+		future_int.Async(func() (int, error) { return 2, nil }),
+		future_int.Success(3),
+	)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []int{1, 2, 3}, values)
+}
+
+func TestAllFailsFast(t *testing.T) {
+	err := errors.New("The error")
+
+	values, got := future_int.All(
+		context.Background(),
+		future_int.NewPromise().Future(),
+		future_int.Failure(err),
+	)
+
+	assert.Equal(t, err, got)
+	assert.Equal(t, []int(nil), values)
+}
+
+func TestAnyReturnsFirstSuccess(t *testing.T) {
+	value, err := future_string.Any(
+		future_string.Failure(errors.New("The error")),
+		future_string.NewPromise().Future(),
+		future_string.Success("fast"),
+	).Await(context.Background())
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "fast", value)
+}
+
+func TestAnyFailsWhenAllFail(t *testing.T) {
+	err1 := errors.New("The error")
+	err2 := errors.New("The other error")
+
+	_, err := future_string.Any(
+		future_string.Failure(err1),
+		future_string.Async(func() (string, error) { return "", err2 }),
+	).Await(context.Background())
+
+	assert.True(t, errors.Is(err, err1))
+	assert.True(t, errors.Is(err, err2))
+}
+
+func TestRaceReturnsFirstCompleted(t *testing.T) {
+	err := errors.New("The error")
+
+	_, got := future_string.Race(
+		future_string.NewPromise().Future(),
+		future_string.Failure(err),
+	).Await(context.Background())
+
+	assert.Equal(t, err, got)
+	_, got = future_string.Race().Await(context.Background())
+	assert.Equal(t, future_string.ErrNoFutures, got)
+}
+
+func TestConversionToResult(t *testing.T) {
+	f := future_int.Async(func() (int, error) { return 4, nil })
+
+	r := result_int.NewResult(f.Await(context.Background()))
+	assert.Equal(t, result_int.Success(4), r)
+}
@@ -0,0 +1,140 @@
+package journal
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+const filePermission = 0664
+
+type failableFunc func() error
+
+type Journal struct {
+	mutex     sync.Mutex
+	path      string
+	file      *os.File
+	completed map[string]json.RawMessage
+}
+
+type entry struct {
+	Step   string          `json:"step"`
+	Output json.RawMessage `json:"output,omitempty"`
+}
+
+func Open(path string) (*Journal, error) {
+	completed, size, err := load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, filePermission)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := file.Truncate(size); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &Journal{path: path, file: file, completed: completed}, nil
+}
+
+func (j *Journal) Step(name string, output interface{}, fn failableFunc) func() error {
+	return func() error {
+		if raw, ok := j.lookup(name); ok {
+			return restore(raw, output)
+		}
+
+		if err := fn(); err != nil {
+			return err
+		}
+
+		return j.record(name, output)
+	}
+}
+
+func (j *Journal) Completed(name string) bool {
+	_, ok := j.lookup(name)
+	return ok
+}
+
+func (j *Journal) Close() error {
+	return j.file.Close()
+}
+
+func (j *Journal) Remove() error {
+	if err := j.Close(); err != nil {
+		return err
+	}
+	return os.Remove(j.path)
+}
+
+func (j *Journal) lookup(name string) (json.RawMessage, bool) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	raw, ok := j.completed[name]
+	return raw, ok
+}
+
+func (j *Journal) record(name string, output interface{}) error {
+	e := entry{Step: name}
+	if output != nil {
+		raw, err := json.Marshal(output)
+		if err != nil {
+			return err
+		}
+		e.Output = raw
+	}
+
+	line, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if _, err := j.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	if err := j.file.Sync(); err != nil {
+		return err
+	}
+
+	j.completed[name] = e.Output
+	return nil
+}
+
+func restore(raw json.RawMessage, output interface{}) error {
+	if output == nil || raw == nil {
+		return nil
+	}
+	return json.Unmarshal(raw, output)
+}
+
+func load(path string) (map[string]json.RawMessage, int64, error) {
+	completed := map[string]json.RawMessage{}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return completed, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// last line is incomplete when the process crashed mid-write
+	size := bytes.LastIndexByte(data, '\n') + 1
+	for _, line := range bytes.Split(data[:size], []byte{'\n'}) {
+		var e entry
+		if err := json.Unmarshal(line, &e); err != nil {
+			continue
+		}
+		completed[e.Step] = e.Output
+	}
+
+	return completed, int64(size), nil
+}
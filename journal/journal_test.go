@@ -0,0 +1,145 @@
+package journal
+
+import (
+	"errors"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type batch struct {
+	IDs []int `json:"ids"`
+}
+
+func runBatch(j *Journal, crashAt string, executed *[]string) (batch, int, error) {
+	var (
+		fetched batch
+		total   int
+	)
+
+	step := func(name string, fn func() error) func() error {
+		return func() error {
+			*executed = append(*executed, name)
+			if name == crashAt {
+				return errors.New("Crashed at " + name)
+			}
+			return fn()
+		}
+	}
+
+	err := errorMonad.Chain(
+		j.Step("fetch", &fetched, step("fetch", func() error {
+			fetched = batch{[]int{1, 2, 3}}
+			return nil
+		})),
+		j.Step("sum", &total, step("sum", func() error {
+			for _, id := range fetched.IDs {
+				total += id
+			}
+			return nil
+		})),
+		j.Step("store", nil, step("store", func() error { return nil })),
+	).Err()
+
+	return fetched, total, err
+}
+
+func TestResumesAtFailedStepAfterCrash(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "batch.journal")
+
+	j, err := Open(path)
+	assert.Equal(t, nil, err)
+	executed := []string{}
+	_, _, err = runBatch(j, "store", &executed)
+	j.Close()
+
+	assert.Equal(t, errors.New("Crashed at store"), err)
+	assert.Equal(t, []string{"fetch", "sum", "store"}, executed)
+
+	j, err = Open(path)
+	assert.Equal(t, nil, err)
+	executed = []string{}
+	fetched, total, err := runBatch(j, "", &executed)
+	j.Close()
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"store"}, executed)
+	assert.Equal(t, batch{[]int{1, 2, 3}}, fetched)
+	assert.Equal(t, 6, total)
+}
+
+func TestFreshJournalRunsAllSteps(t *testing.T) {
+	j, err := Open(filepath.Join(t.TempDir(), "batch.journal"))
+	assert.Equal(t, nil, err)
+	defer j.Close()
+
+	executed := []string{}
+	_, total, err := runBatch(j, "", &executed)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"fetch", "sum", "store"}, executed)
+	assert.Equal(t, 6, total)
+	assert.Equal(t, true, j.Completed("store"))
+}
+
+func TestFailedStepIsNotRecorded(t *testing.T) {
+	j, err := Open(filepath.Join(t.TempDir(), "batch.journal"))
+	assert.Equal(t, nil, err)
+	defer j.Close()
+
+	executed := []string{}
+	runBatch(j, "sum", &executed)
+
+	assert.Equal(t, true, j.Completed("fetch"))
+	assert.Equal(t, false, j.Completed("sum"))
+}
+
+func TestIncompleteLastLineIsIgnored(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "batch.journal")
+	content := `{"step":"fetch","output":{"ids":[4,5]}}` + "\n" + `{"step":"sum","out`
+	assert.Equal(t, nil, os.WriteFile(path, []byte(content), 0664))
+
+	j, err := Open(path)
+	assert.Equal(t, nil, err)
+
+	executed := []string{}
+	fetched, total, err := runBatch(j, "", &executed)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"sum", "store"}, executed)
+	assert.Equal(t, batch{[]int{4, 5}}, fetched)
+	assert.Equal(t, 9, total)
+	assert.Equal(t, nil, j.Close())
+
+	reopened, err := Open(path)
+	assert.Equal(t, nil, err)
+	defer reopened.Close()
+
+	assert.Equal(t, true, reopened.Completed("fetch"))
+	assert.Equal(t, true, reopened.Completed("sum"))
+	assert.Equal(t, true, reopened.Completed("store"))
+}
+
+func TestCorruptedLineIsSkipped(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "batch.journal")
+	content := `{"step":"fetch"}` + "\n" + `garbage` + "\n" + `{"step":"sum"}` + "\n"
+	assert.Equal(t, nil, os.WriteFile(path, []byte(content), 0664))
+
+	j, err := Open(path)
+	assert.Equal(t, nil, err)
+	defer j.Close()
+
+	assert.Equal(t, true, j.Completed("fetch"))
+	assert.Equal(t, true, j.Completed("sum"))
+}
+
+func TestRemove(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "batch.journal")
+	j, _ := Open(path)
+
+	assert.Equal(t, nil, j.Remove())
+	_, err := os.Stat(path)
+	assert.Equal(t, true, os.IsNotExist(err))
+}
@@ -0,0 +1,84 @@
+package limit
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type BulkheadOptions struct {
+	Capacity int
+	MaxWait  time.Duration
+}
+
+type Bulkhead struct {
+	slots   chan struct{}
+	maxWait time.Duration
+	stats   stats
+}
+
+var ErrBulkheadFull = errors.New("Bulkhead is full")
+
+func NewBulkhead(options BulkheadOptions) *Bulkhead {
+	if options.Capacity < 1 {
+		options.Capacity = 1
+	}
+	return &Bulkhead{
+		slots:   make(chan struct{}, options.Capacity),
+		maxWait: options.MaxWait,
+	}
+}
+
+func (b *Bulkhead) Do(ctx context.Context, fn func() error) error {
+	if err := b.acquire(ctx); err != nil {
+		return b.stats.reject(err)
+	}
+	defer b.release()
+
+	b.stats.accept()
+	return fn()
+}
+
+func (b *Bulkhead) Wrap(fn func(context.Context) error) func(context.Context) error {
+	return wrap(b, fn)
+}
+
+func (b *Bulkhead) InFlight() int {
+	return len(b.slots)
+}
+
+func (b *Bulkhead) Stats() Stats {
+	return b.stats.snapshot()
+}
+
+func (b *Bulkhead) acquire(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	select {
+	case b.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	if b.maxWait <= 0 {
+		return ErrBulkheadFull
+	}
+
+	timer := time.NewTimer(b.maxWait)
+	defer timer.Stop()
+
+	select {
+	case b.slots <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return ErrBulkheadFull
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *Bulkhead) release() {
+	<-b.slots
+}
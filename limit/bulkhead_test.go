@@ -0,0 +1,123 @@
+package limit
+
+import (
+	"context"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBulkheadCallsProvidedBlock(t *testing.T) {
+	b := NewBulkhead(BulkheadOptions{Capacity: 1})
+	executed := false
+
+	err := b.Do(context.Background(), func() error { executed = true; return nil })
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, executed)
+	assert.Equal(t, Stats{Accepted: 1}, b.Stats())
+}
+
+func TestBulkheadCapsConcurrency(t *testing.T) {
+	b := NewBulkhead(BulkheadOptions{Capacity: 2, MaxWait: time.Second})
+
+	var (
+		mutex    sync.Mutex
+		inFlight int
+		maximum  int
+		wg       sync.WaitGroup
+	)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			b.Do(context.Background(), func() error {
+				mutex.Lock()
+				inFlight++
+				if inFlight > maximum {
+					maximum = inFlight
+				}
+				mutex.Unlock()
+
+				time.Sleep(5 * time.Millisecond)
+
+				mutex.Lock()
+				inFlight--
+				mutex.Unlock()
+				return nil
+			})
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2, maximum)
+	assert.Equal(t, Stats{Accepted: 10}, b.Stats())
+}
+
+func TestFullBulkheadRejectsImmediately(t *testing.T) {
+	b := NewBulkhead(BulkheadOptions{Capacity: 1})
+	executed := false
+
+	var err error
+	b.Do(context.Background(), func() error {
+		err = b.Do(context.Background(), func() error { executed = true; return nil })
+		return nil
+	})
+
+	assert.Equal(t, ErrBulkheadFull, err)
+	assert.Equal(t, false, executed)
+	assert.Equal(t, Stats{Accepted: 1, Rejected: 1}, b.Stats())
+	assert.Equal(t, 0, b.InFlight())
+}
+
+func TestBulkheadWaitRespectsContext(t *testing.T) {
+	b := NewBulkhead(BulkheadOptions{Capacity: 1, MaxWait: time.Minute})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	var err error
+	b.Do(context.Background(), func() error {
+		err = b.Do(ctx, func() error { return nil })
+		return nil
+	})
+
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, uint64(1), b.Stats().Rejected)
+}
+
+func TestBulkheadWrapInErrorChain(t *testing.T) {
+	b := NewBulkhead(BulkheadOptions{Capacity: 1})
+	executed := false
+
+	err := errorMonad.Return(nil).BindContext(
+		b.Wrap(func(context.Context) error { executed = true; return nil }),
+	).Err()
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, executed)
+}
+
+func TestBulkheadWrapTakesContextOfEveryChain(t *testing.T) {
+	b := NewBulkhead(BulkheadOptions{Capacity: 1, MaxWait: time.Minute})
+	var stepCtx context.Context
+	step := b.Wrap(func(ctx context.Context) error { stepCtx = ctx; return nil })
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var err error
+	b.Do(context.Background(), func() error {
+		err = errorMonad.WithContext(cancelled).BindContext(step).Err()
+		return nil
+	})
+
+	assert.Equal(t, context.Canceled, err)
+
+	ctx := context.WithValue(context.Background(), traceKey{}, "trace")
+	assert.Equal(t, nil, errorMonad.WithContext(ctx).BindContext(step).Err())
+	assert.Equal(t, "trace", stepCtx.Value(traceKey{}))
+}
+
+type traceKey struct{}
@@ -0,0 +1,39 @@
+package limit
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+type Stats struct {
+	Accepted uint64
+	Rejected uint64
+}
+
+type stats struct {
+	accepted atomic.Uint64
+	rejected atomic.Uint64
+}
+
+type limiter interface {
+	Do(ctx context.Context, fn func() error) error
+}
+
+func wrap(l limiter, fn func(context.Context) error) func(context.Context) error {
+	return func(ctx context.Context) error {
+		return l.Do(ctx, func() error { return fn(ctx) })
+	}
+}
+
+func (s *stats) accept() {
+	s.accepted.Add(1)
+}
+
+func (s *stats) reject(err error) error {
+	s.rejected.Add(1)
+	return err
+}
+
+func (s *stats) snapshot() Stats {
+	return Stats{s.accepted.Load(), s.rejected.Load()}
+}
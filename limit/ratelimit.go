@@ -0,0 +1,112 @@
+package limit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+type RateLimitOptions struct {
+	Rate    float64
+	Burst   int
+	MaxWait time.Duration
+}
+
+type RateLimiter struct {
+	mutex   sync.Mutex
+	options RateLimitOptions
+	tokens  float64
+	last    time.Time
+	stats   stats
+}
+
+var ErrRateLimited = errors.New("Rate limit exceeded")
+
+func NewRateLimiter(options RateLimitOptions) *RateLimiter {
+	if options.Burst < 1 {
+		options.Burst = 1
+	}
+	return &RateLimiter{
+		options: options,
+		tokens:  float64(options.Burst),
+		last:    time.Now(),
+	}
+}
+
+func (r *RateLimiter) Do(ctx context.Context, fn func() error) error {
+	if err := r.wait(ctx); err != nil {
+		return r.stats.reject(err)
+	}
+
+	r.stats.accept()
+	return fn()
+}
+
+func (r *RateLimiter) Wrap(fn func(context.Context) error) func(context.Context) error {
+	return wrap(r, fn)
+}
+
+func (r *RateLimiter) Stats() Stats {
+	return r.stats.snapshot()
+}
+
+func (r *RateLimiter) wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	delay, ok := r.reserve()
+	if !ok {
+		return ErrRateLimited
+	}
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		r.cancel()
+		return ctx.Err()
+	}
+}
+
+func (r *RateLimiter) reserve() (time.Duration, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := time.Now()
+	r.tokens += now.Sub(r.last).Seconds() * r.options.Rate
+	if burst := float64(r.options.Burst); r.tokens > burst {
+		r.tokens = burst
+	}
+	r.last = now
+
+	if r.tokens >= 1 {
+		r.tokens--
+		return 0, true
+	}
+
+	if r.options.Rate <= 0 {
+		return 0, false
+	}
+
+	delay := time.Duration((1 - r.tokens) / r.options.Rate * float64(time.Second))
+	if delay > r.options.MaxWait {
+		return 0, false
+	}
+
+	r.tokens--
+	return delay, true
+}
+
+func (r *RateLimiter) cancel() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.tokens++
+}
@@ -0,0 +1,78 @@
+package limit
+
+import (
+	"context"
+	"github.com/nanoservice/monad.go/result/result_int"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterAllowsBurst(t *testing.T) {
+	r := NewRateLimiter(RateLimitOptions{Rate: 1, Burst: 3})
+	calls := 0
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, nil, r.Do(context.Background(), func() error { calls++; return nil }))
+	}
+
+	assert.Equal(t, 3, calls)
+}
+
+func TestRateLimiterRejectsWhenWaitIsTooLong(t *testing.T) {
+	r := NewRateLimiter(RateLimitOptions{Rate: 1, Burst: 1})
+	r.Do(context.Background(), func() error { return nil })
+
+	err := r.Do(context.Background(), func() error { return nil })
+
+	assert.Equal(t, ErrRateLimited, err)
+	assert.Equal(t, Stats{Accepted: 1, Rejected: 1}, r.Stats())
+}
+
+func TestRateLimiterWaitsForToken(t *testing.T) {
+	r := NewRateLimiter(RateLimitOptions{Rate: 50, Burst: 1, MaxWait: time.Second})
+	started := time.Now()
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, nil, r.Do(context.Background(), func() error { return nil }))
+	}
+
+	assert.True(t, time.Since(started) >= 30*time.Millisecond)
+}
+
+func TestRateLimiterWaitRespectsContext(t *testing.T) {
+	r := NewRateLimiter(RateLimitOptions{Rate: 1, Burst: 1, MaxWait: time.Minute})
+	r.Do(context.Background(), func() error { return nil })
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := r.Do(ctx, func() error { return nil })
+
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, uint64(1), r.Stats().Rejected)
+}
+
+func TestRateLimiterWithResultGuard(t *testing.T) {
+	r := NewRateLimiter(RateLimitOptions{Rate: 1, Burst: 1})
+	step := result_int.GuardContext(
+		r.Do,
+		func(_ context.Context, x int) result_int.Result { return result_int.Success(x + 1) },
+	)
+
+	assert.Equal(t, result_int.Success(2), result_int.Success(1).BindContext(step))
+	assert.Equal(t, result_int.Failure(ErrRateLimited), result_int.Success(1).BindContext(step))
+}
+
+func TestRateLimiterWithResultGuardTakesChainContext(t *testing.T) {
+	r := NewRateLimiter(RateLimitOptions{Rate: 1, Burst: 1, MaxWait: time.Minute})
+	step := result_int.GuardContext(
+		r.Do,
+		func(_ context.Context, x int) result_int.Result { return result_int.Success(x + 1) },
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.Equal(t, context.Canceled, result_int.Success(1).WithContext(ctx).BindContext(step).Err())
+	assert.Equal(t, uint64(0), r.Stats().Accepted)
+	assert.Equal(t, result_int.Success(2), result_int.Success(1).BindContext(step))
+}
@@ -0,0 +1,104 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"log/slog"
+	"time"
+)
+
+type Options struct {
+	Logger       *slog.Logger
+	SuccessLevel slog.Leveler
+	FailureLevel slog.Leveler
+}
+
+const (
+	SucceededMessage = "Step succeeded"
+	FailedMessage    = "Step failed"
+)
+
+func New(options Options) errorMonad.Interceptor {
+	options = withDefaults(options)
+
+	return func(step errorMonad.Step, next func() error) error {
+		started := time.Now()
+		err := next()
+		duration := time.Since(started)
+
+		if err == nil {
+			log(step.Context(), options.Logger, options.SuccessLevel.Level(), SucceededMessage,
+				slog.String("step", step.Name),
+				slog.Duration("duration", duration),
+			)
+			return nil
+		}
+
+		log(step.Context(), options.Logger, options.FailureLevel.Level(), FailedMessage,
+			slog.String("step", step.Name),
+			slog.Duration("duration", duration),
+			slog.String("error", err.Error()),
+			slog.Any("chain", Chain(err)),
+		)
+		return err
+	}
+}
+
+func Report(logger *slog.Logger, message string) func(error) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return func(err error) {
+		log(context.Background(), logger, slog.LevelError, message,
+			slog.String("error", err.Error()),
+			slog.Any("chain", Chain(err)),
+		)
+	}
+}
+
+func Chain(err error) []string {
+	chain := []string{}
+	for _, err := range flatten(err) {
+		chain = append(chain, err.Error())
+	}
+	return chain
+}
+
+func flatten(err error) []error {
+	if err == nil {
+		return nil
+	}
+
+	result := []error{err}
+	switch wrapped := err.(type) {
+	case interface{ Unwrap() []error }:
+		for _, inner := range wrapped.Unwrap() {
+			result = append(result, flatten(inner)...)
+		}
+	default:
+		result = append(result, flatten(errors.Unwrap(err))...)
+	}
+	return result
+}
+
+func log(ctx context.Context, logger *slog.Logger, level slog.Level, message string, attrs ...slog.Attr) {
+	if !logger.Enabled(ctx, level) {
+		return
+	}
+	logger.LogAttrs(ctx, level, message, attrs...)
+}
+
+func withDefaults(options Options) Options {
+	if options.Logger == nil {
+		options.Logger = slog.Default()
+	}
+	if options.SuccessLevel == nil {
+		options.SuccessLevel = slog.LevelDebug
+	}
+	if options.FailureLevel == nil {
+		options.FailureLevel = slog.LevelError
+	}
+	return options
+}
@@ -0,0 +1,153 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"testing"
+)
+
+var errNotFound = errors.New("404 Not Found")
+
+func resolveTemplate() error { return nil }
+func downloadTemplate() error {
+	return fmt.Errorf("Unable to download template: %w", errNotFound)
+}
+
+func TestNewLogsEveryStep(t *testing.T) {
+	recorder := NewRecorder()
+	logger := slog.New(recorder)
+
+	errorMonad.Intercept(New(Options{Logger: logger})).Chain(
+		resolveTemplate,
+		downloadTemplate,
+	)
+
+	records := recorder.Records()
+	assert.Equal(t, 2, len(records))
+
+	assert.Equal(t, slog.LevelDebug, records[0].Level)
+	assert.Equal(t, SucceededMessage, records[0].Message)
+	assert.Equal(t, errorMonad.StepName(resolveTemplate), Attrs(records[0])["step"].String())
+
+	failure := Attrs(records[1])
+	assert.Equal(t, slog.LevelError, records[1].Level)
+	assert.Equal(t, FailedMessage, records[1].Message)
+	assert.Equal(t, errorMonad.StepName(downloadTemplate), failure["step"].String())
+	assert.Equal(t, slog.KindDuration, failure["duration"].Kind())
+	assert.Equal(t, "Unable to download template: 404 Not Found", failure["error"].String())
+	assert.Equal(t, []string{
+		"Unable to download template: 404 Not Found",
+		"404 Not Found",
+	}, failure["chain"].Any())
+}
+
+func TestNewUsesConfiguredLevels(t *testing.T) {
+	recorder := NewRecorder()
+	logger := slog.New(recorder)
+
+	errorMonad.Intercept(New(Options{
+		Logger:       logger,
+		SuccessLevel: slog.LevelInfo,
+		FailureLevel: slog.LevelWarn,
+	})).Chain(resolveTemplate, downloadTemplate)
+
+	records := recorder.Records()
+	assert.Equal(t, slog.LevelInfo, records[0].Level)
+	assert.Equal(t, slog.LevelWarn, records[1].Level)
+}
+
+func TestNewSkipsDisabledLevels(t *testing.T) {
+	recorder := NewRecorder()
+	logger := slog.New(&minLevel{recorder, slog.LevelInfo})
+
+	err := errorMonad.Intercept(New(Options{Logger: logger})).Chain(
+		resolveTemplate,
+		downloadTemplate,
+	).Err()
+
+	assert.Equal(t, true, errors.Is(err, errNotFound))
+	assert.Equal(t, 1, len(recorder.Records()))
+	assert.Equal(t, FailedMessage, recorder.Records()[0].Message)
+}
+
+func TestReportLogsErrorChain(t *testing.T) {
+	recorder := NewRecorder()
+	logger := slog.New(recorder)
+
+	errorMonad.Bind(downloadTemplate).OnErrorFn(Report(logger, "Unable to install template"))
+
+	records := recorder.Records()
+	assert.Equal(t, 1, len(records))
+	assert.Equal(t, "Unable to install template", records[0].Message)
+	assert.Equal(t, slog.LevelError, records[0].Level)
+	assert.Equal(t, 2, len(Attrs(records[0])["chain"].Any().([]string)))
+}
+
+func TestChainFlattensJoinedErrors(t *testing.T) {
+	first := errors.New("first")
+	second := fmt.Errorf("second: %w", errNotFound)
+
+	assert.Equal(t, []string{
+		"first\nsecond: 404 Not Found",
+		"first",
+		"second: 404 Not Found",
+		"404 Not Found",
+	}, Chain(errors.Join(first, second)))
+}
+
+func TestRecorderKeepsHandlerAttrsAndGroups(t *testing.T) {
+	recorder := NewRecorder()
+	logger := slog.New(recorder).With("service", "installer").WithGroup("request")
+
+	logger.Info("Fetched", "url", "http://example.org")
+
+	records := recorder.Records()
+	assert.Equal(t, 1, len(records))
+	attrs := Attrs(records[0])
+	assert.Equal(t, "installer", attrs["service"].String())
+	assert.Equal(t, "http://example.org", attrs["request"].Group()[0].Value.String())
+
+	recorder.Reset()
+	assert.Equal(t, 0, len(recorder.Records()))
+}
+
+type traceKey struct{}
+
+func TestNewLogsUnderStepContext(t *testing.T) {
+	recorder := NewRecorder()
+	handler := &contextValues{Handler: recorder}
+	ctx := context.WithValue(context.Background(), traceKey{}, "trace-1")
+
+	errorMonad.WithContext(ctx).
+		Intercept(New(Options{Logger: slog.New(handler)})).
+		Intercept(func(step errorMonad.Step, next func() error) error {
+			step.SetContext(context.WithValue(step.Context(), traceKey{}, "trace-2"))
+			return next()
+		}).
+		Bind(resolveTemplate)
+
+	assert.Equal(t, []interface{}{"trace-2"}, handler.values)
+}
+
+type contextValues struct {
+	slog.Handler
+	values []interface{}
+}
+
+func (h *contextValues) Handle(ctx context.Context, record slog.Record) error {
+	h.values = append(h.values, ctx.Value(traceKey{}))
+	return h.Handler.Handle(ctx, record)
+}
+
+type minLevel struct {
+	slog.Handler
+	level slog.Level
+}
+
+func (h *minLevel) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.level
+}
@@ -0,0 +1,89 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+type Recorder struct {
+	store  *store
+	attrs  []slog.Attr
+	groups []string
+}
+
+type store struct {
+	mutex   sync.Mutex
+	records []slog.Record
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{store: &store{}}
+}
+
+func (r *Recorder) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (r *Recorder) Handle(_ context.Context, record slog.Record) error {
+	own := []slog.Attr{}
+	record.Attrs(func(attr slog.Attr) bool {
+		own = append(own, attr)
+		return true
+	})
+
+	result := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+	result.AddAttrs(r.attrs...)
+	result.AddAttrs(grouped(r.groups, own)...)
+
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	r.store.records = append(r.store.records, result)
+	return nil
+}
+
+func (r *Recorder) WithAttrs(attrs []slog.Attr) slog.Handler {
+	result := *r
+	result.attrs = append(append([]slog.Attr(nil), r.attrs...), grouped(r.groups, attrs)...)
+	return &result
+}
+
+func (r *Recorder) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return r
+	}
+	result := *r
+	result.groups = append(append([]string(nil), r.groups...), name)
+	return &result
+}
+
+func (r *Recorder) Records() []slog.Record {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	return append([]slog.Record(nil), r.store.records...)
+}
+
+func (r *Recorder) Reset() {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	r.store.records = nil
+}
+
+func Attrs(record slog.Record) map[string]slog.Value {
+	result := map[string]slog.Value{}
+	record.Attrs(func(attr slog.Attr) bool {
+		result[attr.Key] = attr.Value
+		return true
+	})
+	return result
+}
+
+func grouped(groups []string, attrs []slog.Attr) []slog.Attr {
+	if len(attrs) == 0 {
+		return attrs
+	}
+	for i := len(groups) - 1; i >= 0; i-- {
+		attrs = []slog.Attr{{Key: groups[i], Value: slog.GroupValue(attrs...)}}
+	}
+	return attrs
+}
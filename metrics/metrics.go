@@ -0,0 +1,19 @@
+package metrics
+
+import (
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"time"
+)
+
+type Recorder interface {
+	Observe(step string, duration time.Duration, err error)
+}
+
+func New(recorder Recorder) errorMonad.Interceptor {
+	return func(step errorMonad.Step, next func() error) error {
+		started := time.Now()
+		err := next()
+		recorder.Observe(step.Name, time.Since(started), err)
+		return err
+	}
+}
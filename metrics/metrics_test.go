@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/nanoservice/monad.go/result/result_int"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+	"time"
+)
+
+var errQuotaExceeded = errors.New("Storage quota exceeded")
+
+func resizeImage() error { return nil }
+func uploadImage() error { return errQuotaExceeded }
+func readImage() error {
+	return &os.PathError{Op: "open", Path: "image.png", Err: os.ErrNotExist}
+}
+
+func TestNewCountsOutcomesByStep(t *testing.T) {
+	stats := NewStats()
+	metered := errorMonad.Intercept(New(stats))
+
+	metered.Chain(resizeImage, uploadImage)
+	metered.Chain(resizeImage, readImage)
+	metered.Chain(resizeImage, uploadImage)
+
+	snapshot := stats.Snapshot()
+	assert.Equal(t, int64(3), snapshot[errorMonad.StepName(resizeImage)].Successes)
+	assert.Equal(t, map[string]int64{}, snapshot[errorMonad.StepName(resizeImage)].Failures)
+	assert.Equal(t,
+		map[string]int64{"*errors.errorString": 2},
+		snapshot[errorMonad.StepName(uploadImage)].Failures,
+	)
+	assert.Equal(t,
+		map[string]int64{"*fs.PathError": 1},
+		snapshot[errorMonad.StepName(readImage)].Failures,
+	)
+	assert.Equal(t, int64(3), snapshot[errorMonad.StepName(resizeImage)].Latency.Count)
+}
+
+func TestNewMetersResultSteps(t *testing.T) {
+	stats := NewStats()
+	double := func(x int) result_int.Result { return result_int.Success(x * 2) }
+
+	result_int.Success(1).Intercept(New(stats)).Chain(double, double)
+
+	assert.Equal(t, int64(2), stats.Snapshot()[errorMonad.StepName(double)].Successes)
+}
+
+func TestStatsPutsLatencyIntoBuckets(t *testing.T) {
+	stats := NewStats(10*time.Millisecond, time.Millisecond)
+
+	stats.Observe("step", 500*time.Microsecond, nil)
+	stats.Observe("step", time.Millisecond, nil)
+	stats.Observe("step", 3*time.Millisecond, nil)
+	stats.Observe("step", time.Second, errQuotaExceeded)
+
+	latency := stats.Snapshot()["step"].Latency
+	assert.Equal(t, []time.Duration{time.Millisecond, 10 * time.Millisecond}, latency.Buckets)
+	assert.Equal(t, []int64{2, 1, 1}, latency.Counts)
+	assert.Equal(t, int64(4), latency.Count)
+	assert.Equal(t, 1004500*time.Microsecond, latency.Sum)
+}
+
+func TestSnapshotIsCopy(t *testing.T) {
+	stats := NewStats()
+	stats.Observe("step", time.Millisecond, errQuotaExceeded)
+
+	snapshot := stats.Snapshot()
+	stats.Observe("step", time.Millisecond, errQuotaExceeded)
+
+	assert.Equal(t, int64(1), snapshot["step"].Failures["*errors.errorString"])
+	assert.Equal(t, int64(1), snapshot["step"].Latency.Count)
+}
+
+func TestPublishExposesStatsViaExpvar(t *testing.T) {
+	stats := NewStats()
+	name := fmt.Sprintf("monad_test_steps_%p", stats)
+
+	assert.Equal(t, nil, stats.Publish(name))
+	stats.Observe("step", time.Millisecond, nil)
+
+	exposed := map[string]StepStats{}
+	err := json.Unmarshal([]byte(expvar.Get(name).String()), &exposed)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, int64(1), exposed["step"].Successes)
+}
+
+func TestPublishRejectsTakenName(t *testing.T) {
+	stats := NewStats()
+	name := fmt.Sprintf("monad_test_steps_%p", stats)
+	stats.Publish(name)
+
+	assert.Equal(t, ErrAlreadyPublished, NewStats().Publish(name))
+}
@@ -0,0 +1,122 @@
+package metrics
+
+import (
+	"errors"
+	"expvar"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+type Stats struct {
+	mutex   sync.Mutex
+	buckets []time.Duration
+	steps   map[string]*StepStats
+}
+
+type StepStats struct {
+	Successes int64            `json:"successes"`
+	Failures  map[string]int64 `json:"failures"`
+	Latency   Histogram        `json:"latency"`
+}
+
+type Histogram struct {
+	Buckets []time.Duration `json:"buckets"`
+	Counts  []int64         `json:"counts"`
+	Count   int64           `json:"count"`
+	Sum     time.Duration   `json:"sum"`
+}
+
+var DefaultBuckets = []time.Duration{
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+func NewStats(buckets ...time.Duration) *Stats {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	buckets = append([]time.Duration(nil), buckets...)
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i] < buckets[j] })
+
+	return &Stats{buckets: buckets, steps: map[string]*StepStats{}}
+}
+
+func (s *Stats) Observe(step string, duration time.Duration, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stats, ok := s.steps[step]
+	if !ok {
+		stats = &StepStats{
+			Failures: map[string]int64{},
+			Latency: Histogram{
+				Buckets: s.buckets,
+				Counts:  make([]int64, len(s.buckets)+1),
+			},
+		}
+		s.steps[step] = stats
+	}
+
+	if err == nil {
+		stats.Successes++
+	} else {
+		stats.Failures[ErrorType(err)]++
+	}
+	stats.Latency.observe(duration)
+}
+
+func (s *Stats) Snapshot() map[string]StepStats {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	result := make(map[string]StepStats, len(s.steps))
+	for step, stats := range s.steps {
+		failures := make(map[string]int64, len(stats.Failures))
+		for kind, count := range stats.Failures {
+			failures[kind] = count
+		}
+
+		latency := stats.Latency
+		latency.Counts = append([]int64(nil), latency.Counts...)
+
+		result[step] = StepStats{stats.Successes, failures, latency}
+	}
+	return result
+}
+
+var ErrAlreadyPublished = errors.New("Expvar variable is already published")
+
+var publishing sync.Mutex
+
+func (s *Stats) Publish(name string) error {
+	publishing.Lock()
+	defer publishing.Unlock()
+
+	if expvar.Get(name) != nil {
+		return ErrAlreadyPublished
+	}
+	expvar.Publish(name, expvar.Func(func() interface{} {
+		return s.Snapshot()
+	}))
+	return nil
+}
+
+func ErrorType(err error) string {
+	return fmt.Sprintf("%T", err)
+}
+
+func (h *Histogram) observe(duration time.Duration) {
+	i := sort.Search(len(h.Buckets), func(i int) bool { return duration <= h.Buckets[i] })
+	h.Counts[i]++
+	h.Count++
+	h.Sum += duration
+}
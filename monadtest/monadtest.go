@@ -0,0 +1,71 @@
+package monadtest
+
+import (
+	"errors"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"reflect"
+)
+
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+type Monad interface {
+	Err() error
+}
+
+type Valued[T any] interface {
+	Peek() (T, error)
+}
+
+func AssertSuccess[T any](t TestingT, r Valued[T], want T) bool {
+	t.Helper()
+
+	value, err := r.Peek()
+	if err != nil {
+		t.Errorf("Expected success with %#v, got failure: %v", want, err)
+		return false
+	}
+	if !reflect.DeepEqual(want, value) {
+		t.Errorf("Expected success with %#v, got success with %#v", want, value)
+		return false
+	}
+	return true
+}
+
+func AssertNoError(t TestingT, m Monad) bool {
+	t.Helper()
+
+	if err := m.Err(); err != nil {
+		t.Errorf("Expected success, got failure: %v", err)
+		return false
+	}
+	return true
+}
+
+func AssertFailureIs(t TestingT, m Monad, target error) bool {
+	t.Helper()
+
+	err := m.Err()
+	if err == nil {
+		t.Errorf("Expected failure with %v, got success", target)
+		return false
+	}
+	if !errors.Is(err, target) {
+		t.Errorf("Expected failure with %v, got failure: %v", target, err)
+		return false
+	}
+	return true
+}
+
+func AssertDeferredRan(t TestingT, inspection *errorMonad.Inspection, count int) bool {
+	t.Helper()
+
+	executed, pending := inspection.DeferredExecuted(), inspection.DeferredPending()
+	if executed != count || pending != 0 {
+		t.Errorf("Expected %d deferred function(s) to run, %d ran and %d pending", count, executed, pending)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+package monadtest
+
+import (
+	"errors"
+	"fmt"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/nanoservice/monad.go/result/result_int"
+	"github.com/nanoservice/monad.go/result/result_string"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type fakeT struct {
+	errors []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+var errRefused = errors.New("Connection refused")
+
+func TestAssertSuccessOfResult(t *testing.T) {
+	AssertSuccess(t, result_int.Success(42), 42)
+	AssertSuccess(t, result_string.Success("world").Defer(func(string) {}), "world")
+
+	fake := &fakeT{}
+	assert.Equal(t, false, AssertSuccess(fake, result_int.Success(41), 42))
+	assert.Equal(t, false, AssertSuccess(fake, result_int.Failure(errRefused), 42))
+	assert.Equal(t, []string{
+		"Expected success with 42, got success with 41",
+		"Expected success with 42, got failure: Connection refused",
+	}, fake.errors)
+}
+
+func TestAssertSuccessDoesNotRunDeferred(t *testing.T) {
+	ran := false
+	r := result_int.Success(1).Defer(func(int) { ran = true })
+
+	AssertSuccess(t, r, 1)
+
+	assert.Equal(t, false, ran)
+}
+
+func TestAssertNoError(t *testing.T) {
+	AssertNoError(t, errorMonad.Return(nil))
+	AssertNoError(t, result_int.Success(1))
+
+	fake := &fakeT{}
+	assert.Equal(t, false, AssertNoError(fake, errorMonad.Return(errRefused)))
+	assert.Equal(t, []string{"Expected success, got failure: Connection refused"}, fake.errors)
+}
+
+func TestAssertFailureIs(t *testing.T) {
+	wrapped := fmt.Errorf("Unable to connect: %w", errRefused)
+	AssertFailureIs(t, errorMonad.Return(wrapped), errRefused)
+	AssertFailureIs(t, result_int.Failure(wrapped), errRefused)
+
+	fake := &fakeT{}
+	assert.Equal(t, false, AssertFailureIs(fake, result_int.Success(1), errRefused))
+	assert.Equal(t, false, AssertFailureIs(fake, errorMonad.Return(errors.New("Timeout")), errRefused))
+	assert.Equal(t, []string{
+		"Expected failure with Connection refused, got success",
+		"Expected failure with Connection refused, got failure: Timeout",
+	}, fake.errors)
+}
+
+func TestAssertDeferredRan(t *testing.T) {
+	inspection := errorMonad.NewInspection()
+	e := errorMonad.Inspect(inspection).Defer(func() {}).Defer(func() {})
+
+	fake := &fakeT{}
+	assert.Equal(t, false, AssertDeferredRan(fake, inspection, 2))
+	assert.Equal(t, []string{"Expected 2 deferred function(s) to run, 0 ran and 2 pending"}, fake.errors)
+
+	e.Err()
+	AssertDeferredRan(t, inspection, 2)
+
+	inspection = errorMonad.NewInspection()
+	result_int.Success(1).Inspect(inspection).Defer(func(int) {}).Err()
+	AssertDeferredRan(t, inspection, 1)
+}
@@ -0,0 +1,94 @@
+package program
+
+import (
+	"context"
+	errorMonad "github.com/nanoservice/monad.go/error"
+)
+
+type failableFunc func() error
+type deferrableFunc func()
+
+type Program struct {
+	steps []step
+}
+
+type Step struct {
+	Kind string
+	Name string
+}
+
+type step struct {
+	Step
+	apply func(ctx context.Context, e errorMonad.Error) errorMonad.Error
+}
+
+const (
+	BindStep  = "bind"
+	DeferStep = "defer"
+)
+
+func Bind(fn failableFunc) Program {
+	return Program{}.Bind(fn)
+}
+
+func Chain(fns ...func() error) Program {
+	return Program{}.Chain(fns...)
+}
+
+func (p Program) Bind(fn failableFunc) Program {
+	name := errorMonad.StepName(fn)
+	return p.append(step{
+		Step{BindStep, name},
+		func(ctx context.Context, e errorMonad.Error) errorMonad.Error {
+			return e.BindNamed(name, func() error {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				return fn()
+			})
+		},
+	})
+}
+
+func (p Program) Chain(fns ...func() error) (result Program) {
+	result = p
+	for _, fn := range fns {
+		result = result.Bind(fn)
+	}
+	return
+}
+
+func (p Program) Defer(fn deferrableFunc) Program {
+	return p.append(step{
+		Step{DeferStep, errorMonad.StepName(fn)},
+		func(_ context.Context, e errorMonad.Error) errorMonad.Error {
+			return e.Defer(func() { fn() })
+		},
+	})
+}
+
+func (p Program) Then(next Program) Program {
+	return p.append(next.steps...)
+}
+
+func (p Program) Steps() []Step {
+	steps := make([]Step, len(p.steps))
+	for i, s := range p.steps {
+		steps[i] = s.Step
+	}
+	return steps
+}
+
+func (p Program) Run(ctx context.Context) error {
+	e := errorMonad.Return(nil)
+	for _, s := range p.steps {
+		e = s.apply(ctx, e)
+	}
+	return e.Err()
+}
+
+func (p Program) append(steps ...step) Program {
+	result := make([]step, 0, len(p.steps)+len(steps))
+	result = append(result, p.steps...)
+	return Program{append(result, steps...)}
+}
@@ -0,0 +1,158 @@
+package program
+
+import (
+	"context"
+	"errors"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestBindDoesNotExecuteProvidedBlockUntilRun(t *testing.T) {
+	executed := false
+	p := Bind(func() error {
+		executed = true
+		return nil
+	})
+
+	assert.Equal(t, false, executed)
+	assert.Equal(t, nil, p.Run(context.Background()))
+	assert.Equal(t, true, executed)
+}
+
+func TestRunCanBeRepeated(t *testing.T) {
+	runs := 0
+	p := Chain(
+		func() error { runs++; return nil },
+		func() error { runs++; return nil },
+	)
+
+	p.Run(context.Background())
+	p.Run(context.Background())
+
+	assert.Equal(t, 4, runs)
+}
+
+func TestRunStopsAtFirstError(t *testing.T) {
+	err := errors.New("Very peculiar error")
+	executed := false
+
+	got := Chain(
+		func() error { return err },
+		func() error { executed = true; return nil },
+	).Run(context.Background())
+
+	assert.Equal(t, err, got)
+	assert.Equal(t, false, executed)
+}
+
+func TestRetryAsAWhole(t *testing.T) {
+	err := errors.New("Temporary error")
+	attempts := 0
+
+	p := Bind(func() error {
+		attempts++
+		if attempts < 3 {
+			return err
+		}
+		return nil
+	})
+
+	var got error
+	for i := 0; i < 3; i++ {
+		if got = p.Run(context.Background()); got == nil {
+			break
+		}
+	}
+
+	assert.Equal(t, nil, got)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestDeferIsExecutedOnEveryRun(t *testing.T) {
+	closed := 0
+	p := Bind(func() error { return nil }).
+		Defer(func() { closed++ }).
+		Bind(func() error { return errors.New("The error") })
+
+	p.Run(context.Background())
+	p.Run(context.Background())
+
+	assert.Equal(t, 2, closed)
+}
+
+func TestDeferAfterErrorIsNotExecuted(t *testing.T) {
+	executed := false
+
+	Bind(func() error { return errors.New("The error") }).
+		Defer(func() { executed = true }).
+		Run(context.Background())
+
+	assert.Equal(t, false, executed)
+}
+
+func TestRunRespectsContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	executed := false
+
+	got := Chain(
+		func() error { cancel(); return nil },
+		func() error { executed = true; return nil },
+	).Run(ctx)
+
+	assert.Equal(t, context.Canceled, got)
+	assert.Equal(t, false, executed)
+}
+
+func TestBranchingDoesNotShareSteps(t *testing.T) {
+	base := Bind(func() error { return nil })
+	a := base.Bind(func() error { return nil })
+	b := base.Defer(func() {})
+
+	assert.Equal(t, 1, len(base.Steps()))
+	assert.Equal(t, BindStep, a.Steps()[1].Kind)
+	assert.Equal(t, DeferStep, b.Steps()[1].Kind)
+}
+
+func TestThen(t *testing.T) {
+	order := []string{}
+	fetch := Bind(func() error { order = append(order, "fetch"); return nil })
+	store := Bind(func() error { order = append(order, "store"); return nil })
+
+	fetch.Then(store).Run(context.Background())
+
+	assert.Equal(t, []string{"fetch", "store"}, order)
+}
+
+func TestSteps(t *testing.T) {
+	p := Bind(connect).Defer(disconnect)
+
+	assert.Equal(t, []Step{
+		{BindStep, "github.com/nanoservice/monad.go/program.connect"},
+		{DeferStep, "github.com/nanoservice/monad.go/program.disconnect"},
+	}, p.Steps())
+}
+
+func connect() error { return nil }
+func disconnect()    {}
+
+func TestRunReportsStepNamesToInterceptors(t *testing.T) {
+	names := []string{}
+	errorMonad.Use(func(step errorMonad.Step, next func() error) error {
+		names = append(names, step.Name)
+		return next()
+	})
+	defer errorMonad.ResetInterceptors()
+
+	Bind(connect).Run(context.Background())
+
+	assert.Equal(t, []string{"github.com/nanoservice/monad.go/program.connect"}, names)
+}
+
+func TestBindAcceptsBudgetedSteps(t *testing.T) {
+	p := Bind(errorMonad.WithBudget(time.Second, connect, connect))
+
+	assert.Equal(t, nil, p.Run(context.Background()))
+	assert.Equal(t, nil, p.Run(context.Background()))
+}
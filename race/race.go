@@ -0,0 +1,99 @@
+package race
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+type attempt[T any] func(ctx context.Context) (T, error)
+
+type outcome[T any] struct {
+	value T
+	err   error
+}
+
+var ErrNoAttempts = errors.New("No attempts were provided")
+
+func First[T any](ctx context.Context, fns ...func(context.Context) (T, error)) (T, error) {
+	return compete(ctx, 0, toAttempts(fns))
+}
+
+func Hedge[T any](ctx context.Context, delay time.Duration, fn func(context.Context) (T, error)) (T, error) {
+	return compete(ctx, delay, []attempt[T]{fn, fn})
+}
+
+func compete[T any](parent context.Context, delay time.Duration, attempts []attempt[T]) (value T, err error) {
+	if len(attempts) == 0 {
+		return value, ErrNoAttempts
+	}
+
+	ctx, cancel := context.WithCancel(parent)
+	defer cancel()
+
+	outcomes := make(chan outcome[T], len(attempts))
+	errs := make([]error, 0, len(attempts))
+	launched, running := 0, 0
+
+	launch := func() {
+		fn := attempts[launched]
+		launched++
+		running++
+		go func() {
+			value, err := fn(ctx)
+			outcomes <- outcome[T]{value, err}
+		}()
+	}
+
+	launch()
+	for delay <= 0 && launched < len(attempts) {
+		launch()
+	}
+
+	var tick <-chan time.Time
+	if launched < len(attempts) {
+		ticker := time.NewTicker(delay)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for running > 0 {
+		select {
+		case o := <-outcomes:
+			running--
+			if o.err == nil {
+				return o.value, nil
+			}
+
+			errs = append(errs, o.err)
+			if running == 0 && launched < len(attempts) {
+				launch()
+			}
+
+		case <-tick:
+			if launched < len(attempts) {
+				launch()
+			}
+
+		case <-parent.Done():
+			return value, parent.Err()
+		}
+
+		if launched == len(attempts) {
+			tick = nil
+		}
+	}
+
+	if len(errs) == 1 {
+		return value, errs[0]
+	}
+	return value, errors.Join(errs...)
+}
+
+func toAttempts[T any](fns []func(context.Context) (T, error)) []attempt[T] {
+	attempts := make([]attempt[T], len(fns))
+	for i, fn := range fns {
+		attempts[i] = fn
+	}
+	return attempts
+}
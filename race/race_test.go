@@ -0,0 +1,136 @@
+package race
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func respondAfter(d time.Duration, value string, err error) func(context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		select {
+		case <-time.After(d):
+			return value, err
+		case <-ctx.Done():
+			return "", ctx.Err()
+		}
+	}
+}
+
+func TestFirstReturnsFastestSuccess(t *testing.T) {
+	value, err := First(
+		context.Background(),
+		respondAfter(50*time.Millisecond, "primary", nil),
+		respondAfter(time.Millisecond, "replica", nil),
+	)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "replica", value)
+}
+
+func TestFirstSkipsFailures(t *testing.T) {
+	value, err := First(
+		context.Background(),
+		respondAfter(time.Millisecond, "", errors.New("The error")),
+		respondAfter(10*time.Millisecond, "replica", nil),
+	)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "replica", value)
+}
+
+func TestFirstFailsWhenAllFail(t *testing.T) {
+	err1 := errors.New("The error")
+	err2 := errors.New("The other error")
+
+	_, err := First(
+		context.Background(),
+		respondAfter(time.Millisecond, "", err1),
+		respondAfter(time.Millisecond, "", err2),
+	)
+
+	assert.Equal(t, true, errors.Is(err, err1))
+	assert.Equal(t, true, errors.Is(err, err2))
+
+	_, err = First[string](context.Background())
+	assert.Equal(t, ErrNoAttempts, err)
+}
+
+func TestFirstCancelsLosers(t *testing.T) {
+	cancelled := make(chan struct{})
+
+	First(
+		context.Background(),
+		respondAfter(time.Millisecond, "fast", nil),
+		func(ctx context.Context) (string, error) {
+			<-ctx.Done()
+			close(cancelled)
+			return "", ctx.Err()
+		},
+	)
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("loser was not cancelled")
+	}
+}
+
+func TestHedgeDoesNotFireSecondAttemptForFastResponse(t *testing.T) {
+	var attempts atomic.Int32
+
+	value, err := Hedge(context.Background(), 50*time.Millisecond, func(ctx context.Context) (string, error) {
+		attempts.Add(1)
+		return "fast", nil
+	})
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "fast", value)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestHedgeFiresSecondAttemptAfterDelay(t *testing.T) {
+	var attempts atomic.Int32
+
+	value, err := Hedge(context.Background(), 10*time.Millisecond, func(ctx context.Context) (string, error) {
+		if attempts.Add(1) == 1 {
+			return respondAfter(time.Second, "slow", nil)(ctx)
+		}
+		return "hedged", nil
+	})
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "hedged", value)
+	assert.Equal(t, int32(2), attempts.Load())
+}
+
+func TestHedgeFiresSecondAttemptRightAfterFailure(t *testing.T) {
+	var attempts atomic.Int32
+	started := time.Now()
+
+	value, err := Hedge(context.Background(), time.Second, func(ctx context.Context) (string, error) {
+		if attempts.Add(1) == 1 {
+			return "", errors.New("The error")
+		}
+		return "retried", nil
+	})
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "retried", value)
+	assert.True(t, time.Since(started) < time.Second)
+}
+
+func TestFirstRespectsContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := First(ctx, func(context.Context) (string, error) {
+		time.Sleep(50 * time.Millisecond)
+		return "late", nil
+	})
+
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
@@ -0,0 +1 @@
+reader.go.t
\ No newline at end of file
@@ -0,0 +1,72 @@
+// Code generated by github.com/nanoservice/monad.go/reader
+// reader monad
+// environment type: {{T}}
+package reader_{{t}}
+
+import ({{I}})
+
+type handler      func({{T}}) error
+type deferHandler func({{T}})
+
+type Reader struct {
+        steps []step
+}
+
+type step struct {
+        bind     handler
+        deferred deferHandler
+}
+
+func Bind(fn handler) Reader {
+        return Reader{}.Bind(fn)
+}
+
+func Chain(fns... handler) Reader {
+        return Reader{}.Chain(fns...)
+}
+
+func (r Reader) Bind(fn handler) Reader {
+        return r.append(step{bind: fn})
+}
+
+func (r Reader) Chain(fns... handler) Reader {
+        for _, fn := range fns {
+                r = r.Bind(fn)
+        }
+        return r
+}
+
+func (r Reader) Defer(fn deferHandler) Reader {
+        return r.append(step{deferred: fn})
+}
+
+func (r Reader) Then(next Reader) Reader {
+        return r.append(next.steps...)
+}
+
+func (r Reader) Run(env {{T}}) (err error) {
+        deferred := []deferHandler{}
+        for _, s := range r.steps {
+                if err != nil {
+                        break
+                }
+
+                if s.deferred != nil {
+                        deferred = append(deferred, s.deferred)
+                        continue
+                }
+
+                err = s.bind(env)
+        }
+
+        for _, fn := range deferred {
+                fn(env)
+        }
+        return
+}
+
+func (r Reader) append(steps... step) Reader {
+        result := make([]step, 0, len(r.steps)+len(steps))
+        result = append(result, r.steps...)
+        return Reader{append(result, steps...)}
+}
@@ -0,0 +1,84 @@
+//go:generate nanotemplate -T string --input=_reader.tt.go
+//go:generate nanotemplate -T io.Writer -t writer -I io --input=_reader.tt.go
+package reader
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/nanoservice/monad.go/reader/reader_string"
+	"github.com/nanoservice/monad.go/reader/reader_writer"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+)
+
+func TestBindDoesNotExecuteProvidedBlockUntilRun(t *testing.T) {
+	var got string
+	r := reader_string.Bind(func(env string) error {
+		got = env
+		return nil
+	})
+
+	assert.Equal(t, "", got)
+	assert.Equal(t, nil, r.Run("production"))
+	assert.Equal(t, "production", got)
+}
+
+func TestEnvironmentIsSuppliedOnceAtRun(t *testing.T) {
+	greet := reader_writer.Chain(
+		func(out io.Writer) error { _, err := fmt.Fprint(out, "hello, "); return err },
+		func(out io.Writer) error { _, err := fmt.Fprint(out, "world"); return err },
+	)
+
+	fake := &bytes.Buffer{}
+	assert.Equal(t, nil, greet.Run(fake))
+	assert.Equal(t, "hello, world", fake.String())
+}
+
+func TestRunStopsAtFirstError(t *testing.T) {
+	err := errors.New("Very peculiar error")
+	executed := false
+
+	got := reader_string.Chain(
+		func(_ string) error { return err },
+		func(_ string) error { executed = true; return nil },
+	).Run("test")
+
+	assert.Equal(t, err, got)
+	assert.Equal(t, false, executed)
+}
+
+func TestDeferIsExecutedWithEnvironment(t *testing.T) {
+	var got string
+
+	err := reader_string.
+		Bind(func(_ string) error { return nil }).
+		Defer(func(env string) { got = env }).
+		Bind(func(_ string) error { return errors.New("The error") }).
+		Run("test")
+
+	assert.Equal(t, errors.New("The error"), err)
+	assert.Equal(t, "test", got)
+}
+
+func TestDeferAfterErrorIsNotExecuted(t *testing.T) {
+	executed := false
+
+	reader_string.
+		Bind(func(_ string) error { return errors.New("The error") }).
+		Defer(func(_ string) { executed = true }).
+		Run("test")
+
+	assert.Equal(t, false, executed)
+}
+
+func TestThen(t *testing.T) {
+	out := &bytes.Buffer{}
+	hello := reader_writer.Bind(func(out io.Writer) error { _, err := fmt.Fprint(out, "hello"); return err })
+	bye := reader_writer.Bind(func(out io.Writer) error { _, err := fmt.Fprint(out, ", bye"); return err })
+
+	hello.Then(bye).Run(out)
+
+	assert.Equal(t, "hello, bye", out.String())
+}
@@ -0,0 +1,49 @@
+package result
+
+import (
+	"errors"
+	"github.com/nanoservice/monad.go/result/result_int"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func addTwo(x int) result_int.Result { return result_int.Success(x + 2) }
+func double(x int) result_int.Result { return result_int.Success(x * 2) }
+
+func TestComposeChainsHandlers(t *testing.T) {
+	addTwoAndDouble := result_int.Compose(addTwo, double)
+
+	assert.Equal(t, result_int.Success(18), result_int.Success(7).Bind(addTwoAndDouble))
+	assert.Equal(t, result_int.Success(10), addTwoAndDouble(3))
+}
+
+func TestComposeStopsAtFirstFailure(t *testing.T) {
+	err := errors.New("The error")
+	executed := false
+
+	r := result_int.Success(1).Bind(result_int.Compose(
+		func(int) result_int.Result { return result_int.Failure(err) },
+		func(x int) result_int.Result { executed = true; return result_int.Success(x) },
+	))
+
+	assert.Equal(t, result_int.Failure(err), r)
+	assert.Equal(t, false, executed)
+}
+
+func TestComposeCanBeNested(t *testing.T) {
+	inner := result_int.Compose(addTwo, double)
+	outer := result_int.Compose(inner, inner)
+
+	assert.Equal(t, result_int.Success(16), result_int.Success(1).Bind(outer))
+}
+
+func TestComposeKeepsDeferred(t *testing.T) {
+	var got int
+	open := func(x int) result_int.Result {
+		return result_int.Success(x).Defer(func(x int) { got = x })
+	}
+
+	result_int.Success(5).Bind(result_int.Compose(open, double)).Err()
+
+	assert.Equal(t, 5, got)
+}
@@ -0,0 +1,30 @@
+package result
+
+import (
+	"bytes"
+	"errors"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/nanoservice/monad.go/result/result_int"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func failing(int) result_int.Result { return result_int.Failure(errors.New("Out of range")) }
+func release(int)                   {}
+
+func TestDebugPrintsResultChainExecution(t *testing.T) {
+	out := &bytes.Buffer{}
+	errorMonad.Debug(out)
+	defer errorMonad.Debug(nil)
+
+	result_int.Success(1).Defer(release).Chain(addTwo, failing, double).Err()
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(t, 4, len(lines))
+	assert.Regexp(t, regexp.MustCompile(`^monad: step .*\.addTwo succeeded in \S+$`), lines[0])
+	assert.Regexp(t, regexp.MustCompile(`^monad: step .*\.failing failed in \S+: Out of range$`), lines[1])
+	assert.Regexp(t, regexp.MustCompile(`^monad: step .*\.double skipped: Out of range$`), lines[2])
+	assert.Regexp(t, regexp.MustCompile(`^monad: deferred .*\.release$`), lines[3])
+}
@@ -0,0 +1,60 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"github.com/nanoservice/monad.go/breaker"
+	"github.com/nanoservice/monad.go/result/result_int"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestGuardPassesResultThrough(t *testing.T) {
+	b := breaker.New(breaker.Options{FailureThreshold: 1, CoolDown: time.Minute})
+	addTwo := func(x int) result_int.Result { return result_int.Success(x + 2) }
+
+	r := result_int.Success(7).Bind(result_int.Guard(b.Do, addTwo))
+
+	assert.Equal(t, result_int.Success(9), r)
+}
+
+func TestGuardWithOpenBreakerFailsFast(t *testing.T) {
+	err := errors.New("The error")
+	b := breaker.New(breaker.Options{FailureThreshold: 1, CoolDown: time.Minute})
+	calls := 0
+	step := result_int.Guard(b.Do, func(x int) result_int.Result {
+		calls++
+		return result_int.Failure(err)
+	})
+
+	assert.Equal(t, err, result_int.Success(1).Bind(step).Err())
+
+	got := result_int.Success(1).Bind(step).Err()
+	assert.Equal(t, true, errors.Is(got, breaker.ErrOpen))
+	assert.Equal(t, 1, calls)
+}
+
+func TestGuardPassesUncomparableErrorThrough(t *testing.T) {
+	err := multiErr{[]string{"a", "b"}}
+	b := breaker.New(breaker.Options{FailureThreshold: 2, CoolDown: time.Minute})
+	step := result_int.Guard(b.Do, func(int) result_int.Result {
+		return result_int.Failure(err)
+	})
+
+	assert.Equal(t, err, result_int.Success(1).Bind(step).Err())
+}
+
+func TestGuardContextPassesChainContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), requestKey{}, "chain")
+	var doCtx, stepCtx context.Context
+	do := func(ctx context.Context, fn func() error) error { doCtx = ctx; return fn() }
+	step := result_int.GuardContext(do, func(ctx context.Context, x int) result_int.Result {
+		stepCtx = ctx
+		return result_int.Success(x + 1)
+	})
+
+	assert.Equal(t, nil, result_int.Success(1).WithContext(ctx).BindContext(step).Err())
+	assert.Equal(t, "chain", doCtx.Value(requestKey{}))
+	assert.Equal(t, "chain", stepCtx.Value(requestKey{}))
+}
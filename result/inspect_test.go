@@ -0,0 +1,44 @@
+package result
+
+import (
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/nanoservice/monad.go/result/result_int"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestInspectRecordsResultChainExecution(t *testing.T) {
+	inspection := errorMonad.NewInspection()
+	deferredDouble := func(x int) result_int.Result {
+		return result_int.Success(x * 2).Defer(release)
+	}
+
+	r := result_int.Success(1).
+		Inspect(inspection).
+		Defer(release).
+		Chain(deferredDouble, failing, addTwo)
+
+	assert.Equal(t, []string{
+		errorMonad.StepName(deferredDouble),
+		errorMonad.StepName(failing),
+	}, inspection.Ran())
+	assert.Equal(t, []string{errorMonad.StepName(addTwo)}, inspection.Skipped())
+	assert.Equal(t, errorMonad.StepName(failing), inspection.Failed())
+	assert.Equal(t, 2, inspection.DeferredPending())
+
+	r.Err()
+
+	assert.Equal(t, 0, inspection.DeferredPending())
+	assert.Equal(t, 2, inspection.DeferredExecuted())
+}
+
+func TestInspectCountsResultDeferredAttachedBeforeInspect(t *testing.T) {
+	inspection := errorMonad.NewInspection()
+
+	r := result_int.Success(1).Defer(release).Inspect(inspection)
+	assert.Equal(t, 1, inspection.DeferredPending())
+
+	r.Err()
+	assert.Equal(t, 0, inspection.DeferredPending())
+	assert.Equal(t, 1, inspection.DeferredExecuted())
+}
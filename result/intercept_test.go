@@ -0,0 +1,96 @@
+package result
+
+import (
+	"context"
+	"errors"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/nanoservice/monad.go/result/result_int"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+func TestInterceptReceivesStepInput(t *testing.T) {
+	inputs := []interface{}{}
+
+	r := result_int.Success(3).Intercept(func(step errorMonad.Step, next func() error) error {
+		inputs = append(inputs, step.Input)
+		return next()
+	}).Chain(addTwo, double)
+
+	assert.Equal(t, 10, valueOf(r))
+	assert.Equal(t, []interface{}{3, 5}, inputs)
+}
+
+func TestInterceptCanReplaceOutcome(t *testing.T) {
+	err := errors.New("Rejected by policy")
+
+	r := result_int.Success(3).Intercept(func(step errorMonad.Step, next func() error) error {
+		return err
+	}).Bind(double)
+
+	assert.Equal(t, err, r.Err())
+}
+
+func TestInterceptCanSkipStep(t *testing.T) {
+	r := result_int.Success(3).Intercept(func(step errorMonad.Step, next func() error) error {
+		return nil
+	}).Bind(double)
+
+	assert.Equal(t, 3, valueOf(r))
+}
+
+func TestGlobalInterceptorsWrapResultSteps(t *testing.T) {
+	steps := 0
+	errorMonad.Use(func(step errorMonad.Step, next func() error) error {
+		steps++
+		return next()
+	})
+	defer errorMonad.ResetInterceptors()
+
+	result_int.Success(1).Chain(addTwo, double, addTwo)
+
+	assert.Equal(t, 3, steps)
+}
+
+func valueOf(r result_int.Result) (value int) {
+	r.Defer(func(x int) { value = x }).Err()
+	return
+}
+
+type requestKey struct{}
+
+func TestBindContextReceivesContextSetByInterceptor(t *testing.T) {
+	ctx := context.WithValue(context.Background(), requestKey{}, "request-1")
+	requests := []interface{}{}
+
+	r := result_int.Success(3).
+		WithContext(ctx).
+		Intercept(func(step errorMonad.Step, next func() error) error {
+			requests = append(requests, step.Context().Value(requestKey{}))
+			step.SetContext(context.WithValue(step.Context(), requestKey{}, "request-2"))
+			return next()
+		}).
+		BindContext(func(ctx context.Context, x int) result_int.Result {
+			requests = append(requests, ctx.Value(requestKey{}))
+			return result_int.Success(x + 1)
+		})
+
+	assert.Equal(t, 4, valueOf(r))
+	assert.Equal(t, []interface{}{"request-1", "request-2"}, requests)
+}
+
+type multiErr struct{ messages []string }
+
+func (e multiErr) Error() string { return strings.Join(e.messages, "; ") }
+
+func TestInterceptPassesUncomparableErrorThrough(t *testing.T) {
+	err := multiErr{[]string{"a", "b"}}
+	pass := func(step errorMonad.Step, next func() error) error { return next() }
+
+	r := result_int.Success(1).Intercept(pass).Bind(func(int) result_int.Result {
+		return result_int.Failure(err)
+	})
+
+	assert.Equal(t, err, r.Err())
+}
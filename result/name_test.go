@@ -0,0 +1,20 @@
+package result
+
+import (
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/nanoservice/monad.go/result/result_int"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBindNamedReportsHandlerUnderItsName(t *testing.T) {
+	names := []string{}
+
+	r := result_int.Success(1).Intercept(func(step errorMonad.Step, next func() error) error {
+		names = append(names, step.Name)
+		return next()
+	}).BindNamed("double", double).Bind(addTwo)
+
+	assert.Equal(t, 4, valueOf(r))
+	assert.Equal(t, []string{"double", errorMonad.StepName(addTwo)}, names)
+}
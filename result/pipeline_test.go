@@ -0,0 +1,150 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"github.com/nanoservice/monad.go/result/result_int"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func feed(values ...int) <-chan int {
+	in := make(chan int)
+	go func() {
+		defer close(in)
+		for _, value := range values {
+			in <- value
+		}
+	}()
+	return in
+}
+
+func collect(out <-chan int, errs <-chan error) ([]int, error) {
+	values := []int{}
+	for value := range out {
+		values = append(values, value)
+	}
+	return values, <-errs
+}
+
+func TestPipelineRunsAllStages(t *testing.T) {
+	out, errs := result_int.NewPipeline(
+		result_int.NewStage(func(x int) result_int.Result { return result_int.Success(x + 1) }, 3),
+		result_int.NewStage(func(x int) result_int.Result { return result_int.Success(x * 10) }, 2),
+	).Run(context.Background(), feed(1, 2, 3, 4))
+
+	values, err := collect(out, errs)
+	sort.Ints(values)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []int{20, 30, 40, 50}, values)
+}
+
+func TestPipelinePreservesOrder(t *testing.T) {
+	slowFirst := func(x int) result_int.Result {
+		time.Sleep(time.Duration(10-x) * time.Millisecond)
+		return result_int.Success(x)
+	}
+
+	out, errs := result_int.NewPipeline(
+		result_int.NewStage(slowFirst, 5),
+	).PreserveOrder().Run(context.Background(), feed(1, 2, 3, 4, 5))
+
+	values, err := collect(out, errs)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, values)
+}
+
+func TestPipelineStopsAtFirstError(t *testing.T) {
+	err := errors.New("The error")
+	in := make(chan int)
+	done := make(chan struct{})
+	processed := 0
+
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			in <- i
+		}
+		close(in)
+	}()
+
+	out, errs := result_int.NewPipeline(
+		result_int.NewStage(func(x int) result_int.Result {
+			processed++
+			if x == 3 {
+				return result_int.Failure(err)
+			}
+			return result_int.Success(x)
+		}, 1),
+	).Run(context.Background(), in)
+
+	_, got := collect(out, errs)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("producer is blocked after the pipeline failed")
+	}
+	assert.Equal(t, err, got)
+	assert.True(t, processed < 1000)
+}
+
+func TestPipelineRespectsContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	out, errs := result_int.NewPipeline(
+		result_int.NewStage(func(x int) result_int.Result { return result_int.Success(x) }, 1),
+	).Run(ctx, make(chan int))
+
+	_, got := collect(out, errs)
+
+	assert.Equal(t, context.Canceled, got)
+}
+
+func TestPipelineRunsDeferredWhenItemLeaves(t *testing.T) {
+	var mutex sync.Mutex
+	released := []int{}
+
+	out, errs := result_int.NewPipeline(
+		result_int.NewStage(func(x int) result_int.Result {
+			return result_int.Success(x).Defer(func(x int) {
+				mutex.Lock()
+				released = append(released, x)
+				mutex.Unlock()
+			})
+		}, 2),
+		result_int.NewStage(func(x int) result_int.Result { return result_int.Success(x * 2) }, 1),
+	).Run(context.Background(), feed(1, 2, 3))
+
+	values, err := collect(out, errs)
+	sort.Ints(values)
+	sort.Ints(released)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []int{2, 4, 6}, values)
+	assert.Equal(t, []int{1, 2, 3}, released)
+}
+
+func TestPipelineRunsDeferredOfFailedItem(t *testing.T) {
+	released := false
+
+	out, errs := result_int.NewPipeline(
+		result_int.NewStage(func(x int) result_int.Result {
+			return result_int.Success(x).Defer(func(_ int) { released = true })
+		}, 1),
+		result_int.NewStage(func(x int) result_int.Result {
+			return result_int.Failure(errors.New("The error"))
+		}, 1),
+	).Run(context.Background(), feed(1))
+
+	_, err := collect(out, errs)
+
+	assert.NotEqual(t, nil, err)
+	assert.Equal(t, true, released)
+}
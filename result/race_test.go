@@ -0,0 +1,105 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"github.com/nanoservice/monad.go/result/result_string"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+type closeLog struct {
+	mutex  sync.Mutex
+	closed []string
+}
+
+func (l *closeLog) close(name string) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.closed = append(l.closed, name)
+}
+
+func (l *closeLog) names() []string {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return append([]string{}, l.closed...)
+}
+
+func fetchFrom(log *closeLog, source string, d time.Duration) func(context.Context, string) result_string.Result {
+	return func(ctx context.Context, key string) result_string.Result {
+		select {
+		case <-time.After(d):
+			return result_string.
+				Success(key + " from " + source).
+				Defer(func(_ string) { log.close(source) })
+		case <-ctx.Done():
+			return result_string.Failure(ctx.Err())
+		}
+	}
+}
+
+func TestRaceTakesFirstSuccess(t *testing.T) {
+	log := &closeLog{}
+
+	r := result_string.Success("user").Bind(result_string.Race(
+		context.Background(),
+		fetchFrom(log, "primary", 50*time.Millisecond),
+		fetchFrom(log, "replica", time.Millisecond),
+	))
+
+	assert.Equal(t, nil, r.Err())
+	assert.Equal(t, []string{"replica"}, log.names())
+}
+
+func TestRaceFailsWhenAllFail(t *testing.T) {
+	err := errors.New("The error")
+	failing := func(context.Context, string) result_string.Result {
+		return result_string.Failure(err)
+	}
+
+	r := result_string.Success("user").Bind(result_string.Race(context.Background(), failing))
+
+	assert.Equal(t, result_string.Failure(err), r)
+}
+
+func TestHedgeRunsDeferredOnlyForWinner(t *testing.T) {
+	log := &closeLog{}
+	var mutex sync.Mutex
+	attempts := 0
+
+	fetch := func(ctx context.Context, key string) result_string.Result {
+		mutex.Lock()
+		attempts++
+		source := "replica"
+		if attempts == 1 {
+			source = "primary"
+		}
+		mutex.Unlock()
+
+		if source == "primary" {
+			return fetchFrom(log, source, time.Second)(ctx, key)
+		}
+		return fetchFrom(log, source, time.Millisecond)(ctx, key)
+	}
+
+	r := result_string.Success("user").
+		Bind(result_string.Hedge(context.Background(), 10*time.Millisecond, fetch))
+
+	assert.Equal(t, nil, r.Err())
+	assert.Equal(t, []string{"replica"}, log.names())
+}
+
+func TestBindKeepsDeferredOfBoundResult(t *testing.T) {
+	order := []string{}
+
+	result_string.Success("file").
+		Defer(func(_ string) { order = append(order, "outer") }).
+		Bind(func(name string) result_string.Result {
+			return result_string.Success(name).Defer(func(_ string) { order = append(order, "inner") })
+		}).
+		Err()
+
+	assert.Equal(t, []string{"outer", "inner"}, order)
+}
@@ -3,17 +3,29 @@
 // type: {{T}}
 package result_{{t}}
 
-import ({{I}})
+import (
+        "context"
+        "reflect"
+        "sync"
+        "time"
+        errorMonad "github.com/nanoservice/monad.go/error"
+        "github.com/nanoservice/monad.go/race"
+        {{I}}
+)
 
 type handler           func({{T}}) Result
 type errorHandler      func(error)
-type deferHandler      func()
+type deferHandler      func(*errorMonad.Inspection)
 type boundDeferHandler func({{T}})
+type contextHandler    func(context.Context, {{T}}) Result
 
 type Result struct {
         value         *{{T}}
         err           error
         deferHandlers []deferHandler
+        interceptors  []errorMonad.Interceptor
+        inspection    *errorMonad.Inspection
+        ctx           context.Context
 }
 
 func NewResult(value {{T}}, err error) Result {
@@ -29,12 +41,15 @@ func Failure(err error) Result {
 }
 
 func (r Result) Bind(fn handler) Result {
-        if r.err != nil {
-          return r
-        }
+        return r.bind(func() string { return errorMonad.StepName(fn) }, ignoreContext(fn))
+}
 
-        result := fn(*r.value)
-        return r.augment(result.value, result.err)
+func (r Result) BindNamed(name string, fn handler) Result {
+        return r.bind(func() string { return name }, ignoreContext(fn))
+}
+
+func (r Result) BindContext(fn contextHandler) Result {
+        return r.bind(func() string { return errorMonad.StepName(fn) }, fn)
 }
 
 func (r Result) Defer(fn boundDeferHandler) Result {
@@ -42,23 +57,38 @@ func (r Result) Defer(fn boundDeferHandler) Result {
                 return r
         }
 
+        errorMonad.AddDeferred(r.inspection, 1)
         return Result{
                 value:         r.value,
                 err:           r.err,
                 deferHandlers: append(
                         r.deferHandlers,
-                        func() { fn(*r.value) },
+                        func(inspection *errorMonad.Inspection) {
+                                name := func() string { return errorMonad.StepName(fn) }
+                                errorMonad.RunDeferred(name, inspection, func() { fn(*r.value) })
+                        },
                 ),
+                interceptors:  r.interceptors,
+                inspection:    r.inspection,
+                ctx:           r.ctx,
         }
 }
 
 func (r Result) Err() error {
         for _, fn := range r.deferHandlers {
-                fn()
+                fn(r.inspection)
         }
         return r.err
 }
 
+func (r Result) Peek() ({{T}}, error) {
+        var value {{T}}
+        if r.value != nil {
+                value = *r.value
+        }
+        return value, r.err
+}
+
 func (r Result) Chain(fns... handler) Result {
         for _, fn := range fns {
                 r = r.Bind(fn)
@@ -66,6 +96,33 @@ func (r Result) Chain(fns... handler) Result {
         return r
 }
 
+func Compose(fns... handler) handler {
+        return func(value {{T}}) Result {
+                return Success(value).Chain(fns...)
+        }
+}
+
+func (r Result) Intercept(fns... errorMonad.Interceptor) Result {
+        r.interceptors = append(
+                append([]errorMonad.Interceptor(nil), r.interceptors...),
+                fns...,
+        )
+        return r
+}
+
+func (r Result) WithContext(ctx context.Context) Result {
+        r.ctx = ctx
+        return r
+}
+
+func (r Result) Inspect(inspection *errorMonad.Inspection) Result {
+        if inspection != r.inspection {
+                errorMonad.AddDeferred(inspection, len(r.deferHandlers))
+        }
+        r.inspection = inspection
+        return r
+}
+
 func (r Result) OnErrorFn(fn errorHandler) Result {
         if r.err != nil {
                 fn(r.err)
@@ -73,10 +130,197 @@ func (r Result) OnErrorFn(fn errorHandler) Result {
         return r
 }
 
-func (r Result) augment(value *{{T}}, err error) (result Result) {
-        result = buildResult(value, err)
-        result.deferHandlers = r.deferHandlers
-        return
+func WithTimeout(d time.Duration, fn handler) handler {
+        return withTimeout(context.Background(), errorMonad.StepName(fn), ignoreContext(fn), fixed(d))
+}
+
+func WithTimeoutContext(ctx context.Context, d time.Duration, fn contextHandler) handler {
+        return withTimeout(ctx, errorMonad.StepName(fn), fn, fixed(d))
+}
+
+func WithBudget(d time.Duration, fns... handler) handler {
+        return func(value {{T}}) Result {
+                budget := errorMonad.NewBudget(d, len(fns))
+                r := Success(value)
+                for _, fn := range fns {
+                        r = r.Bind(withTimeout(context.Background(), errorMonad.StepName(fn), ignoreContext(fn), budget.Share))
+                }
+                return r
+        }
+}
+
+func WithBudgetContext(ctx context.Context, d time.Duration, fns... contextHandler) handler {
+        return func(value {{T}}) Result {
+                budget := errorMonad.NewBudget(d, len(fns))
+                r := Success(value)
+                for _, fn := range fns {
+                        r = r.Bind(withTimeout(ctx, errorMonad.StepName(fn), fn, budget.Share))
+                }
+                return r
+        }
+}
+
+func Guard(do func(func() error) error, fn handler) handler {
+        return func(value {{T}}) Result {
+                called := false
+                var result Result
+                err := do(func() error {
+                        called = true
+                        result = fn(value)
+                        return result.err
+                })
+                return settle(value, called, err, result)
+        }
+}
+
+func GuardContext(do func(context.Context, func() error) error, fn contextHandler) contextHandler {
+        return func(ctx context.Context, value {{T}}) Result {
+                return Guard(func(call func() error) error {
+                        return do(ctx, call)
+                }, func(value {{T}}) Result {
+                        return fn(ctx, value)
+                })(value)
+        }
+}
+
+func Race(ctx context.Context, fns... contextHandler) handler {
+        return func(value {{T}}) Result {
+                attempts := make([]func(context.Context) (Result, error), len(fns))
+                for i, fn := range fns {
+                        attempts[i] = withValue(fn, value)
+                }
+                return raced(race.First(ctx, attempts...))
+        }
+}
+
+func Hedge(ctx context.Context, delay time.Duration, fn contextHandler) handler {
+        return func(value {{T}}) Result {
+                return raced(race.Hedge(ctx, delay, withValue(fn, value)))
+        }
+}
+
+func withValue(fn contextHandler, value {{T}}) func(context.Context) (Result, error) {
+        return func(ctx context.Context) (Result, error) {
+                result := fn(ctx, value)
+                return result, result.err
+        }
+}
+
+func raced(result Result, err error) Result {
+        if err != nil {
+                return Failure(err)
+        }
+        return result
+}
+
+func withTimeout(ctx context.Context, step string, fn contextHandler, timeout func() time.Duration) handler {
+        return func(value {{T}}) Result {
+                d := timeout()
+                if d <= 0 {
+                        return Failure(&errorMonad.TimeoutError{Step: step, Timeout: max(d, 0)})
+                }
+
+                stepCtx, cancel := context.WithTimeout(ctx, d)
+                defer cancel()
+
+                done := make(chan Result, 1)
+                go func() { done <- fn(stepCtx, value) }()
+
+                select {
+                case result := <-done:
+                        return result
+                case <-stepCtx.Done():
+                        go func() { (<-done).Err() }()
+                        if err := ctx.Err(); err != nil {
+                                return Failure(err)
+                        }
+                        return Failure(&errorMonad.TimeoutError{Step: step, Timeout: d})
+                }
+        }
+}
+
+func ignoreContext(fn handler) contextHandler {
+        return func(_ context.Context, value {{T}}) Result {
+                return fn(value)
+        }
+}
+
+func fixed(d time.Duration) func() time.Duration {
+        return func() time.Duration {
+                return d
+        }
+}
+
+func (r Result) augment(result Result) Result {
+        return Result{
+                value:         result.value,
+                err:           result.err,
+                deferHandlers: append(
+                        append([]deferHandler{}, r.deferHandlers...),
+                        result.deferHandlers...,
+                ),
+                interceptors:  r.interceptors,
+                inspection:    r.inspection,
+                ctx:           r.ctx,
+        }
+}
+
+func (r Result) bind(name func() string, fn contextHandler) Result {
+        if r.err != nil {
+          r.skip(name)
+          return r
+        }
+
+        result := r.call(name, fn)
+        if result.inspection != r.inspection {
+                errorMonad.AddDeferred(r.inspection, len(result.deferHandlers))
+        }
+        return r.augment(result)
+}
+
+func (r Result) skip(name func() string) {
+        errorMonad.SkipStep(name, r.err, r.inspection)
+}
+
+func (r Result) call(name func() string, fn contextHandler) Result {
+        value := *r.value
+        called := false
+        var result Result
+        err := errorMonad.RunStep(r.context(), name, value, r.interceptors, r.inspection, func(ctx context.Context) error {
+                called = true
+                result = fn(ctx, value)
+                return result.err
+        })
+
+        return settle(value, called, err, result)
+}
+
+func settle(value {{T}}, called bool, err error, result Result) Result {
+        if called && sameError(err, result.err) {
+                return result
+        }
+        if err != nil {
+                return Failure(err)
+        }
+        return Success(value)
+}
+
+func sameError(err, stepErr error) bool {
+        if err == nil || stepErr == nil {
+                return err == nil && stepErr == nil
+        }
+        t := reflect.TypeOf(err)
+        if t != reflect.TypeOf(stepErr) {
+                return false
+        }
+        return !t.Comparable() || err == stepErr
+}
+
+func (r Result) context() context.Context {
+        if r.ctx == nil {
+                return context.Background()
+        }
+        return r.ctx
 }
 
 func buildResult(value *{{T}}, err error) Result {
@@ -86,3 +330,176 @@ func buildResult(value *{{T}}, err error) Result {
                 deferHandlers: []deferHandler{},
         }
 }
+
+type Stage struct {
+        fn      handler
+        workers int
+}
+
+type Pipeline struct {
+        stages  []Stage
+        ordered bool
+}
+
+type item struct {
+        index  int
+        result Result
+}
+
+func NewStage(fn handler, workers int) Stage {
+        if workers < 1 {
+                workers = 1
+        }
+        return Stage{fn, workers}
+}
+
+func NewPipeline(stages... Stage) Pipeline {
+        return Pipeline{stages: stages}
+}
+
+func (p Pipeline) PreserveOrder() Pipeline {
+        p.ordered = true
+        return p
+}
+
+func (p Pipeline) Run(ctx context.Context, in <-chan {{T}}) (<-chan {{T}}, <-chan error) {
+        var (
+                once     sync.Once
+                firstErr error
+        )
+
+        out := make(chan {{T}})
+        errs := make(chan error, 1)
+        stageCtx, cancel := context.WithCancel(ctx)
+        fail := func(err error) {
+                once.Do(func() {
+                        firstErr = err
+                        cancel()
+                })
+        }
+
+        items := source(stageCtx, in)
+        for _, stage := range p.stages {
+                items = stage.run(stageCtx, items, fail)
+        }
+
+        go func() {
+                defer close(out)
+                defer close(errs)
+                defer cancel()
+
+                p.emit(stageCtx, items, out)
+
+                if firstErr == nil {
+                        firstErr = ctx.Err()
+                }
+                if firstErr != nil {
+                        errs <- firstErr
+                }
+        }()
+
+        return out, errs
+}
+
+func (p Pipeline) emit(ctx context.Context, items <-chan item, out chan<- {{T}}) {
+        next := 0
+        pending := map[int]Result{}
+
+        for it := range items {
+                if !p.ordered {
+                        deliver(ctx, it.result, out)
+                        continue
+                }
+
+                pending[it.index] = it.result
+                for r, ok := pending[next]; ok; r, ok = pending[next] {
+                        delete(pending, next)
+                        next++
+                        deliver(ctx, r, out)
+                }
+        }
+
+        for _, r := range pending {
+                r.Err()
+        }
+}
+
+func (s Stage) run(ctx context.Context, in <-chan item, fail errorHandler) <-chan item {
+        out := make(chan item)
+
+        var wg sync.WaitGroup
+        wg.Add(s.workers)
+        for i := 0; i < s.workers; i++ {
+                go func() {
+                        defer wg.Done()
+                        for it := range in {
+                                if ctx.Err() != nil {
+                                        it.result.Err()
+                                        continue
+                                }
+
+                                it.result = it.result.Bind(s.fn)
+                                if it.result.err != nil {
+                                        fail(it.result.Err())
+                                        continue
+                                }
+
+                                select {
+                                case out <- it:
+                                case <-ctx.Done():
+                                        it.result.Err()
+                                }
+                        }
+                }()
+        }
+
+        go func() {
+                wg.Wait()
+                close(out)
+        }()
+
+        return out
+}
+
+func source(ctx context.Context, in <-chan {{T}}) <-chan item {
+        out := make(chan item)
+
+        go func() {
+                defer close(out)
+                for index := 0; ; index++ {
+                        select {
+                        case value, ok := <-in:
+                                if !ok {
+                                        return
+                                }
+
+                                select {
+                                case out <- item{index, Success(value)}:
+                                case <-ctx.Done():
+                                        go drain(in)
+                                        return
+                                }
+                        case <-ctx.Done():
+                                go drain(in)
+                                return
+                        }
+                }
+        }()
+
+        return out
+}
+
+func drain(in <-chan {{T}}) {
+        for range in {
+        }
+}
+
+func deliver(ctx context.Context, r Result, out chan<- {{T}}) {
+        value := *r.value
+        r.Err()
+
+        select {
+        case out <- value:
+        case <-ctx.Done():
+        }
+}
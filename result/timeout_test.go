@@ -0,0 +1,93 @@
+package result
+
+import (
+	"context"
+	"errors"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/nanoservice/monad.go/result/result_int"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func addSlowly(ctx context.Context, x int) result_int.Result {
+	<-ctx.Done()
+	return result_int.Failure(ctx.Err())
+}
+
+func TestWithTimeoutReturnsResultOfFastStep(t *testing.T) {
+	r := result_int.Success(1).Bind(result_int.WithTimeout(
+		time.Second,
+		func(x int) result_int.Result { return result_int.Success(x + 1) },
+	))
+
+	assert.Equal(t, result_int.Success(2), r)
+}
+
+func TestWithTimeoutFailsWithTypedErrorNamingTheStep(t *testing.T) {
+	err := result_int.Success(1).
+		Bind(result_int.WithTimeoutContext(context.Background(), 10*time.Millisecond, addSlowly)).
+		Err()
+
+	var timeoutErr *errorMonad.TimeoutError
+	assert.Equal(t, true, errors.As(err, &timeoutErr))
+	assert.Equal(t, "github.com/nanoservice/monad.go/result.addSlowly", timeoutErr.Step)
+	assert.Equal(t, 10*time.Millisecond, timeoutErr.Timeout)
+}
+
+func TestWithBudget(t *testing.T) {
+	executed := 0
+	step := func(_ context.Context, x int) result_int.Result { executed++; return result_int.Success(x + 1) }
+
+	r := result_int.Success(0).Bind(result_int.WithBudgetContext(context.Background(), time.Second, step, step, step))
+	assert.Equal(t, result_int.Success(3), r)
+
+	err := result_int.Success(0).
+		Bind(result_int.WithBudgetContext(context.Background(), 30*time.Millisecond, step, addSlowly, step)).
+		Err()
+
+	var timeoutErr *errorMonad.TimeoutError
+	assert.Equal(t, true, errors.As(err, &timeoutErr))
+	assert.Equal(t, "github.com/nanoservice/monad.go/result.addSlowly", timeoutErr.Step)
+	assert.Equal(t, 4, executed)
+}
+
+func TestWithTimeoutRunsDeferredOfLateResult(t *testing.T) {
+	released := make(chan int, 1)
+	slow := func(x int) result_int.Result {
+		time.Sleep(30 * time.Millisecond)
+		return result_int.Success(x).Defer(func(x int) { released <- x })
+	}
+
+	err := result_int.Success(7).Bind(result_int.WithTimeout(time.Millisecond, slow)).Err()
+
+	var timeoutErr *errorMonad.TimeoutError
+	assert.Equal(t, true, errors.As(err, &timeoutErr))
+	assert.Equal(t, 7, <-released)
+}
+
+func TestWithTimeoutContextCancelsSlowStep(t *testing.T) {
+	cancelled := make(chan error, 1)
+
+	err := result_int.Success(1).Bind(result_int.WithTimeoutContext(
+		context.Background(),
+		10*time.Millisecond,
+		func(ctx context.Context, x int) result_int.Result {
+			<-ctx.Done()
+			cancelled <- ctx.Err()
+			return result_int.Failure(ctx.Err())
+		},
+	)).Err()
+
+	var timeoutErr *errorMonad.TimeoutError
+	assert.Equal(t, true, errors.As(err, &timeoutErr))
+	assert.Equal(t, context.DeadlineExceeded, <-cancelled)
+}
+
+func TestWithBudgetStartsOverOnEveryRun(t *testing.T) {
+	run := result_int.WithBudget(200*time.Millisecond, addTwo, double)
+
+	assert.Equal(t, nil, result_int.Success(1).Bind(run).Err())
+	time.Sleep(250 * time.Millisecond)
+	assert.Equal(t, nil, result_int.Success(1).Bind(run).Err())
+}
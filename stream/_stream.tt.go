@@ -0,0 +1 @@
+stream.go.t
\ No newline at end of file
@@ -0,0 +1,157 @@
+// Code generated by github.com/nanoservice/monad.go/stream
+// stream monad
+// type: {{T}}
+package stream_{{t}}
+
+import (
+        "iter"
+        {{I}}
+)
+
+type mapper     func({{T}}) ({{T}}, error)
+type flatMapper func({{T}}) Stream
+type predicate  func({{T}}) bool
+type sequence   iter.Seq2[{{T}}, error]
+
+type Policy int
+
+const (
+        FailFast Policy = iota
+        SkipErrors
+)
+
+type Stream struct {
+        seq    sequence
+        policy Policy
+}
+
+func FromSeq(seq iter.Seq[{{T}}]) Stream {
+        return Stream{seq: func(yield func({{T}}, error) bool) {
+                for value := range seq {
+                        if !yield(value, nil) {
+                                return
+                        }
+                }
+        }}
+}
+
+func FromSeq2(seq iter.Seq2[{{T}}, error]) Stream {
+        return Stream{seq: sequence(seq)}
+}
+
+func Of(values... {{T}}) Stream {
+        return Stream{seq: func(yield func({{T}}, error) bool) {
+                for _, value := range values {
+                        if !yield(value, nil) {
+                                return
+                        }
+                }
+        }}
+}
+
+func Failure(err error) Stream {
+        return Stream{seq: func(yield func({{T}}, error) bool) {
+                var zero {{T}}
+                yield(zero, err)
+        }}
+}
+
+func (s Stream) WithPolicy(policy Policy) Stream {
+        s.policy = policy
+        return s
+}
+
+func (s Stream) Map(fn mapper) Stream {
+        return s.derive(func(yield func({{T}}, error) bool) {
+                for value, err := range s.seq {
+                        if err == nil {
+                                value, err = fn(value)
+                        }
+
+                        if !yield(value, err) {
+                                return
+                        }
+                }
+        })
+}
+
+func (s Stream) FlatMap(fn flatMapper) Stream {
+        return s.derive(func(yield func({{T}}, error) bool) {
+                for value, err := range s.seq {
+                        if err != nil {
+                                if !yield(value, err) {
+                                        return
+                                }
+                                continue
+                        }
+
+                        for inner, err := range fn(value).seq {
+                                if !yield(inner, err) {
+                                        return
+                                }
+                        }
+                }
+        })
+}
+
+func (s Stream) Filter(fn predicate) Stream {
+        return s.derive(func(yield func({{T}}, error) bool) {
+                for value, err := range s.seq {
+                        if err == nil && !fn(value) {
+                                continue
+                        }
+
+                        if !yield(value, err) {
+                                return
+                        }
+                }
+        })
+}
+
+func (s Stream) Take(n int) Stream {
+        return s.derive(func(yield func({{T}}, error) bool) {
+                if n <= 0 {
+                        return
+                }
+
+                taken := 0
+                for value, err := range s.All() {
+                        if !yield(value, err) {
+                                return
+                        }
+
+                        if taken++; taken >= n {
+                                return
+                        }
+                }
+        })
+}
+
+func (s Stream) All() iter.Seq2[{{T}}, error] {
+        return func(yield func({{T}}, error) bool) {
+                for value, err := range s.seq {
+                        if err != nil && s.policy == SkipErrors {
+                                continue
+                        }
+
+                        if !yield(value, err) || err != nil {
+                                return
+                        }
+                }
+        }
+}
+
+func (s Stream) Collect() ([]{{T}}, error) {
+        values := []{{T}}{}
+        for value, err := range s.All() {
+                if err != nil {
+                        return nil, err
+                }
+                values = append(values, value)
+        }
+        return values, nil
+}
+
+func (s Stream) derive(seq sequence) Stream {
+        return Stream{seq: seq, policy: s.policy}
+}
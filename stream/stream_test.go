@@ -0,0 +1,141 @@
+//go:generate nanotemplate -T string --input=_stream.tt.go
+//go:generate nanotemplate -T int --input=_stream.tt.go
+package stream
+
+import (
+	"errors"
+	"github.com/nanoservice/monad.go/stream/stream_int"
+	"github.com/nanoservice/monad.go/stream/stream_string"
+	"github.com/stretchr/testify/assert"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMapFilterCollect(t *testing.T) {
+	values, err := stream_int.Of(1, 2, 3, 4, 5).
+		Map(func(x int) (int, error) { return x * 10, nil }).
+		Filter(func(x int) bool { return x > 20 }).
+		Collect()
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []int{30, 40, 50}, values)
+}
+
+func TestFromSeq(t *testing.T) {
+	values, err := stream_string.
+		FromSeq(slices.Values([]string{"a", "b", "c"})).
+		Map(func(s string) (string, error) { return strings.ToUpper(s), nil }).
+		Collect()
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"A", "B", "C"}, values)
+}
+
+func TestFailFastStopsAtFirstError(t *testing.T) {
+	mapped := []string{}
+
+	values, err := stream_string.Of("1", "two", "3").
+		Map(func(s string) (string, error) {
+			mapped = append(mapped, s)
+			_, err := strconv.Atoi(s)
+			return s, err
+		}).
+		Collect()
+
+	assert.NotEqual(t, nil, err)
+	assert.Equal(t, []string(nil), values)
+	assert.Equal(t, []string{"1", "two"}, mapped)
+}
+
+func TestSkipErrors(t *testing.T) {
+	values, err := stream_string.Of("1", "two", "3").
+		WithPolicy(stream_string.SkipErrors).
+		Map(func(s string) (string, error) {
+			_, err := strconv.Atoi(s)
+			return s, err
+		}).
+		Collect()
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"1", "3"}, values)
+}
+
+func TestFlatMap(t *testing.T) {
+	values, err := stream_int.Of(1, 2, 3).
+		FlatMap(func(x int) stream_int.Stream { return stream_int.Of(x, -x) }).
+		Collect()
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []int{1, -1, 2, -2, 3, -3}, values)
+}
+
+func TestFlatMapPassesErrorsAlong(t *testing.T) {
+	err := errors.New("The error")
+
+	_, got := stream_int.Of(1, 2).
+		FlatMap(func(x int) stream_int.Stream { return stream_int.Failure(err) }).
+		Collect()
+
+	assert.Equal(t, err, got)
+}
+
+func TestTakeIsLazy(t *testing.T) {
+	produced := 0
+	naturals := func(yield func(int) bool) {
+		for i := 1; ; i++ {
+			produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	values, err := stream_int.FromSeq(naturals).
+		Filter(func(x int) bool { return x%2 == 0 }).
+		Take(3).
+		Collect()
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []int{2, 4, 6}, values)
+	assert.Equal(t, 6, produced)
+}
+
+func TestTakeCountsOnlySuccessesWhenSkippingErrors(t *testing.T) {
+	err := errors.New("The error")
+
+	values, got := stream_int.Of(1, 2, 3, 4).
+		WithPolicy(stream_int.SkipErrors).
+		Map(func(x int) (int, error) {
+			if x == 2 {
+				return x, err
+			}
+			return x, nil
+		}).
+		Take(2).
+		Collect()
+
+	assert.Equal(t, nil, got)
+	assert.Equal(t, []int{1, 3}, values)
+}
+
+func TestAllIntegratesWithRangeOverFunc(t *testing.T) {
+	err := errors.New("The error")
+	source := func(yield func(int, error) bool) {
+		_ = yield(1, nil) && yield(0, err) && yield(3, nil)
+	}
+
+	got := []int{}
+	var failure error
+	for value, err := range stream_int.FromSeq2(source).All() {
+		if err != nil {
+			failure = err
+			continue
+		}
+		got = append(got, value)
+	}
+
+	assert.Equal(t, []int{1}, got)
+	assert.Equal(t, err, failure)
+}
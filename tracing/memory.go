@@ -0,0 +1,96 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type InMemory struct {
+	mutex sync.Mutex
+	spans []*RecordedSpan
+}
+
+type RecordedSpan struct {
+	ID         int
+	ParentID   int
+	Name       string
+	Attributes []Attribute
+	Err        error
+	Started    time.Time
+	Ended      time.Time
+}
+
+type memorySpan struct {
+	memory *InMemory
+	span   *RecordedSpan
+}
+
+type spanKey struct{}
+
+func NewInMemory() *InMemory {
+	return &InMemory{}
+}
+
+func (m *InMemory) Start(ctx context.Context, name string) (context.Context, Span) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	parentID, _ := ctx.Value(spanKey{}).(int)
+	span := &RecordedSpan{
+		ID:       len(m.spans) + 1,
+		ParentID: parentID,
+		Name:     name,
+		Started:  time.Now(),
+	}
+	m.spans = append(m.spans, span)
+
+	return context.WithValue(ctx, spanKey{}, span.ID), &memorySpan{m, span}
+}
+
+func (m *InMemory) Spans() []RecordedSpan {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	result := make([]RecordedSpan, len(m.spans))
+	for i, span := range m.spans {
+		result[i] = *span
+		result[i].Attributes = append([]Attribute(nil), span.Attributes...)
+	}
+	return result
+}
+
+func (m *InMemory) Reset() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.spans = nil
+}
+
+func (s RecordedSpan) Attribute(key string) (interface{}, bool) {
+	for i := len(s.Attributes) - 1; i >= 0; i-- {
+		if s.Attributes[i].Key == key {
+			return s.Attributes[i].Value, true
+		}
+	}
+	return nil, false
+}
+
+func (s *memorySpan) SetAttributes(attrs ...Attribute) {
+	s.memory.mutex.Lock()
+	defer s.memory.mutex.Unlock()
+	s.span.Attributes = append(s.span.Attributes, attrs...)
+}
+
+func (s *memorySpan) RecordError(err error) {
+	s.memory.mutex.Lock()
+	defer s.memory.mutex.Unlock()
+	s.span.Err = err
+}
+
+func (s *memorySpan) End() {
+	s.memory.mutex.Lock()
+	defer s.memory.mutex.Unlock()
+	if s.span.Ended.IsZero() {
+		s.span.Ended = time.Now()
+	}
+}
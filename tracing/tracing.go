@@ -0,0 +1,38 @@
+package tracing
+
+import (
+	"context"
+	errorMonad "github.com/nanoservice/monad.go/error"
+)
+
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+const StepAttribute = "monad.step"
+
+func New(tracer Tracer) errorMonad.Interceptor {
+	return func(step errorMonad.Step, next func() error) error {
+		ctx, span := tracer.Start(step.Context(), step.Name)
+		defer span.End()
+		step.SetContext(ctx)
+
+		span.SetAttributes(Attribute{StepAttribute, step.Name})
+		err := next()
+		if err != nil {
+			span.RecordError(err)
+		}
+		return err
+	}
+}
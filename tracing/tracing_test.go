@@ -0,0 +1,103 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/nanoservice/monad.go/result/result_int"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var errQueryTimedOut = errors.New("Query timed out")
+
+func authenticate() error { return nil }
+func queryOrders() error  { return errQueryTimedOut }
+
+func TestNewRecordsSpanPerStep(t *testing.T) {
+	memory := NewInMemory()
+
+	err := errorMonad.Intercept(New(memory)).Chain(
+		authenticate,
+		queryOrders,
+		authenticate,
+	).Err()
+
+	spans := memory.Spans()
+	assert.Equal(t, errQueryTimedOut, err)
+	assert.Equal(t, 2, len(spans))
+
+	assert.Equal(t, errorMonad.StepName(authenticate), spans[0].Name)
+	assert.Equal(t, nil, spans[0].Err)
+	assert.Equal(t, errorMonad.StepName(queryOrders), spans[1].Name)
+	assert.Equal(t, errQueryTimedOut, spans[1].Err)
+
+	step, ok := spans[1].Attribute(StepAttribute)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, errorMonad.StepName(queryOrders), step)
+
+	for _, span := range spans {
+		assert.Equal(t, false, span.Ended.IsZero())
+		assert.Equal(t, false, span.Ended.Before(span.Started))
+	}
+}
+
+func TestNewStartsSpansUnderContext(t *testing.T) {
+	memory := NewInMemory()
+	ctx, root := memory.Start(context.Background(), "install")
+
+	result_int.Success(1).WithContext(ctx).Intercept(New(memory)).Bind(func(x int) result_int.Result {
+		return result_int.Success(x + 1)
+	})
+	root.End()
+
+	spans := memory.Spans()
+	assert.Equal(t, 2, len(spans))
+	assert.Equal(t, 0, spans[0].ParentID)
+	assert.Equal(t, spans[0].ID, spans[1].ParentID)
+}
+
+func TestNewStartsSpansOfNestedChainsUnderStepSpan(t *testing.T) {
+	memory := NewInMemory()
+	errorMonad.Use(New(memory))
+	defer errorMonad.ResetInterceptors()
+
+	errorMonad.BindContext(func(ctx context.Context) error {
+		return errorMonad.WithContext(ctx).Chain(authenticate, queryOrders).Err()
+	}).Err()
+
+	spans := memory.Spans()
+	assert.Equal(t, 3, len(spans))
+	assert.Equal(t, 0, spans[0].ParentID)
+	assert.Equal(t, spans[0].ID, spans[1].ParentID)
+	assert.Equal(t, spans[0].ID, spans[2].ParentID)
+	assert.Equal(t, errQueryTimedOut, spans[0].Err)
+}
+
+func TestNewStartsSpansUnderContextOfEachChain(t *testing.T) {
+	memory := NewInMemory()
+	errorMonad.Use(New(memory))
+	defer errorMonad.ResetInterceptors()
+
+	first, _ := memory.Start(context.Background(), "first request")
+	second, _ := memory.Start(context.Background(), "second request")
+
+	errorMonad.WithContext(first).Bind(authenticate)
+	result_int.Success(1).WithContext(second).BindContext(func(ctx context.Context, x int) result_int.Result {
+		return result_int.Success(x)
+	})
+
+	spans := memory.Spans()
+	assert.Equal(t, 4, len(spans))
+	assert.Equal(t, spans[0].ID, spans[2].ParentID)
+	assert.Equal(t, spans[1].ID, spans[3].ParentID)
+}
+
+func TestInMemoryReset(t *testing.T) {
+	memory := NewInMemory()
+	errorMonad.Intercept(New(memory)).Bind(authenticate)
+
+	memory.Reset()
+
+	assert.Equal(t, 0, len(memory.Spans()))
+}
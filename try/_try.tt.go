@@ -0,0 +1 @@
+try.go.t
\ No newline at end of file
@@ -0,0 +1,70 @@
+package try
+
+import (
+	"errors"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestGenericCallReturnsValue(t *testing.T) {
+	value, err := Call(func() (int, error) {
+		return strconv.Atoi("42")
+	}).Get()
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 42, value)
+}
+
+func TestGenericCallReturnsError(t *testing.T) {
+	_, err := Call(func() (int, error) {
+		return strconv.Atoi("forty two")
+	}).Get()
+
+	assert.NotEqual(t, nil, err)
+}
+
+func TestGenericCallCapturesPanic(t *testing.T) {
+	_, err := Call(func() (string, error) {
+		var names []string
+		return names[3], nil
+	}).Get()
+
+	var panicErr *errorMonad.PanicError
+	assert.Equal(t, true, errors.As(err, &panicErr))
+}
+
+func TestGenericChainStopsAtPanic(t *testing.T) {
+	executed := false
+
+	_, err := Success([]string{"a"}).Chain(
+		func(names []string) Try[[]string] {
+			return Success(names[1:2])
+		},
+		func(names []string) Try[[]string] {
+			executed = true
+			return Success(names)
+		},
+	).Get()
+
+	var panicErr *errorMonad.PanicError
+	assert.Equal(t, true, errors.As(err, &panicErr))
+	assert.Equal(t, false, executed)
+}
+
+func TestGenericDeferRunsOnErr(t *testing.T) {
+	closed := []string{}
+	reported := []error{}
+	failure := errors.New("Unable to parse")
+
+	err := Success("file.txt").
+		Defer(func(name string) { closed = append(closed, name) }).
+		Bind(func(string) Try[string] { return Failure[string](failure) }).
+		OnErrorFn(func(err error) { reported = append(reported, err) }).
+		Err()
+
+	assert.Equal(t, failure, err)
+	assert.Equal(t, []string{"file.txt"}, closed)
+	assert.Equal(t, []error{failure}, reported)
+}
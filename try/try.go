@@ -0,0 +1,102 @@
+package try
+
+import (
+	errorMonad "github.com/nanoservice/monad.go/error"
+)
+
+type Try[T any] struct {
+	value         *T
+	err           error
+	deferHandlers []func()
+}
+
+func Call[T any](fn func() (T, error)) Try[T] {
+	var value T
+	err := errorMonad.Recover(func() (err error) {
+		value, err = fn()
+		return
+	})()
+	return NewTry(value, err)
+}
+
+func NewTry[T any](value T, err error) Try[T] {
+	return buildTry(&value, err)
+}
+
+func Success[T any](value T) Try[T] {
+	return buildTry(&value, nil)
+}
+
+func Failure[T any](err error) Try[T] {
+	return buildTry[T](nil, err)
+}
+
+func (t Try[T]) Bind(fn func(T) Try[T]) Try[T] {
+	if t.err != nil {
+		return t
+	}
+
+	var result Try[T]
+	err := errorMonad.Recover(func() error {
+		result = fn(*t.value)
+		return result.err
+	})()
+	return t.augment(result.value, err)
+}
+
+func (t Try[T]) Defer(fn func(T)) Try[T] {
+	if t.err != nil {
+		return t
+	}
+
+	return Try[T]{
+		value: t.value,
+		err:   t.err,
+		deferHandlers: append(
+			t.deferHandlers,
+			func() { fn(*t.value) },
+		),
+	}
+}
+
+func (t Try[T]) Err() error {
+	for _, fn := range t.deferHandlers {
+		fn()
+	}
+	return t.err
+}
+
+func (t Try[T]) Get() (value T, err error) {
+	if err = t.Err(); err != nil {
+		return
+	}
+	return *t.value, nil
+}
+
+func (t Try[T]) Chain(fns ...func(T) Try[T]) Try[T] {
+	for _, fn := range fns {
+		t = t.Bind(fn)
+	}
+	return t
+}
+
+func (t Try[T]) OnErrorFn(fn func(error)) Try[T] {
+	if t.err != nil {
+		fn(t.err)
+	}
+	return t
+}
+
+func (t Try[T]) augment(value *T, err error) (result Try[T]) {
+	result = buildTry(value, err)
+	result.deferHandlers = t.deferHandlers
+	return
+}
+
+func buildTry[T any](value *T, err error) Try[T] {
+	return Try[T]{
+		value:         value,
+		err:           err,
+		deferHandlers: []func(){},
+	}
+}
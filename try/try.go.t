@@ -0,0 +1,112 @@
+// Code generated by github.com/nanoservice/monad.go/try
+// try monad
+// type: {{T}}
+package try_{{t}}
+
+import (
+        errorMonad "github.com/nanoservice/monad.go/error"
+        {{I}}
+)
+
+type handler           func({{T}}) Try
+type producer          func() ({{T}}, error)
+type errorHandler      func(error)
+type deferHandler      func()
+type boundDeferHandler func({{T}})
+
+type Try struct {
+        value         *{{T}}
+        err           error
+        deferHandlers []deferHandler
+}
+
+func Call(fn producer) Try {
+        var value {{T}}
+        err := errorMonad.Recover(func() (err error) {
+                value, err = fn()
+                return
+        })()
+        return NewTry(value, err)
+}
+
+func NewTry(value {{T}}, err error) Try {
+        return buildTry(&value, err)
+}
+
+func Success(value {{T}}) Try {
+        return buildTry(&value, nil)
+}
+
+func Failure(err error) Try {
+        return buildTry(nil, err)
+}
+
+func (t Try) Bind(fn handler) Try {
+        if t.err != nil {
+                return t
+        }
+
+        var result Try
+        err := errorMonad.Recover(func() error {
+                result = fn(*t.value)
+                return result.err
+        })()
+        return t.augment(result.value, err)
+}
+
+func (t Try) Defer(fn boundDeferHandler) Try {
+        if t.err != nil {
+                return t
+        }
+
+        return Try{
+                value:         t.value,
+                err:           t.err,
+                deferHandlers: append(
+                        t.deferHandlers,
+                        func() { fn(*t.value) },
+                ),
+        }
+}
+
+func (t Try) Err() error {
+        for _, fn := range t.deferHandlers {
+                fn()
+        }
+        return t.err
+}
+
+func (t Try) Get() (value {{T}}, err error) {
+        if err = t.Err(); err != nil {
+                return
+        }
+        return *t.value, nil
+}
+
+func (t Try) Chain(fns... handler) Try {
+        for _, fn := range fns {
+                t = t.Bind(fn)
+        }
+        return t
+}
+
+func (t Try) OnErrorFn(fn errorHandler) Try {
+        if t.err != nil {
+                fn(t.err)
+        }
+        return t
+}
+
+func (t Try) augment(value *{{T}}, err error) (result Try) {
+        result = buildTry(value, err)
+        result.deferHandlers = t.deferHandlers
+        return
+}
+
+func buildTry(value *{{T}}, err error) Try {
+        return Try{
+                value:         value,
+                err:           err,
+                deferHandlers: []deferHandler{},
+        }
+}
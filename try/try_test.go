@@ -0,0 +1,96 @@
+//go:generate nanotemplate -T string --input=_try.tt.go
+//go:generate nanotemplate -T int --input=_try.tt.go
+package try
+
+import (
+	"errors"
+	errorMonad "github.com/nanoservice/monad.go/error"
+	"github.com/nanoservice/monad.go/result/result_int"
+	"github.com/nanoservice/monad.go/try/try_int"
+	"github.com/nanoservice/monad.go/try/try_string"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestCallReturnsValue(t *testing.T) {
+	value, err := try_int.Call(func() (int, error) {
+		return strconv.Atoi("42")
+	}).Get()
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 42, value)
+}
+
+func TestCallCapturesPanic(t *testing.T) {
+	_, err := try_string.Call(func() (string, error) {
+		var names []string
+		return names[3], nil
+	}).Get()
+
+	var panicErr *errorMonad.PanicError
+	assert.Equal(t, true, errors.As(err, &panicErr))
+	assert.NotEmpty(t, panicErr.Stack)
+}
+
+func TestBindCapturesPanic(t *testing.T) {
+	executed := false
+
+	err := try_int.Success(7).Chain(
+		func(x int) try_int.Try {
+			panic("Out of cheese")
+		},
+		func(x int) try_int.Try {
+			executed = true
+			return try_int.Success(x)
+		},
+	).Err()
+
+	var panicErr *errorMonad.PanicError
+	assert.Equal(t, true, errors.As(err, &panicErr))
+	assert.Equal(t, "Out of cheese", panicErr.Value)
+	assert.Equal(t, false, executed)
+}
+
+func TestBindOnFailure(t *testing.T) {
+	err := errors.New("The error")
+	r := try_int.Failure(err).Bind(func(x int) try_int.Try {
+		panic("never")
+	})
+
+	assert.Equal(t, try_int.Failure(err), r)
+}
+
+func TestDeferIsPreservedAfterPanic(t *testing.T) {
+	var got int
+
+	try_int.
+		Success(24).
+		Defer(func(x int) { got = x }).
+		Bind(func(x int) try_int.Try { panic("boom") }).
+		Err()
+
+	assert.Equal(t, 24, got)
+}
+
+func TestOnErrorFn(t *testing.T) {
+	var got error
+	try_int.Call(func() (int, error) { panic("boom") }).
+		OnErrorFn(func(e error) { got = e })
+
+	assert.Equal(t, "Recovered from panic: boom", got.Error())
+}
+
+func TestConversionToResult(t *testing.T) {
+	r := result_int.NewResult(try_int.Success(4).Get())
+	assert.Equal(t, result_int.Success(4), r)
+}
+
+func TestConversionToError(t *testing.T) {
+	e := errorMonad.Bind(func() error {
+		return try_int.Call(func() (int, error) { panic("boom") }).Err()
+	})
+
+	var panicErr *errorMonad.PanicError
+	assert.Equal(t, true, errors.As(e.Err(), &panicErr))
+}
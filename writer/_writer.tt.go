@@ -0,0 +1 @@
+writer.go.t
\ No newline at end of file
@@ -0,0 +1,143 @@
+// Code generated by github.com/nanoservice/monad.go/writer
+// writer monad
+// type: {{T}}
+package writer_{{t}}
+
+import (
+        "context"
+        "errors"
+        "log/slog"
+        "time"
+        {{I}}
+)
+
+type handler           func({{T}}) Writer
+type errorHandler      func(error)
+type deferHandler      func()
+type boundDeferHandler func({{T}})
+
+type Entry struct {
+        Time    time.Time
+        Level   slog.Level
+        Message string
+        Attrs   []slog.Attr
+}
+
+type Writer struct {
+        value         *{{T}}
+        err           error
+        entries       []Entry
+        deferHandlers []deferHandler
+}
+
+func NewWriter(value {{T}}, err error) Writer {
+        return buildWriter(&value, err)
+}
+
+func Success(value {{T}}) Writer {
+        return buildWriter(&value, nil)
+}
+
+func Failure(err error) Writer {
+        return buildWriter(nil, err)
+}
+
+func (w Writer) Tell(level slog.Level, message string, attrs... slog.Attr) Writer {
+        w.entries = appendEntries(w.entries, Entry{
+                Time:    time.Now(),
+                Level:   level,
+                Message: message,
+                Attrs:   attrs,
+        })
+        return w
+}
+
+func (w Writer) Bind(fn handler) Writer {
+        if w.err != nil {
+                return w
+        }
+
+        result := fn(*w.value)
+        return w.augment(result)
+}
+
+func (w Writer) Defer(fn boundDeferHandler) Writer {
+        if w.err != nil {
+                return w
+        }
+
+        return Writer{
+                value:         w.value,
+                err:           w.err,
+                entries:       w.entries,
+                deferHandlers: append(
+                        w.deferHandlers,
+                        func() { fn(*w.value) },
+                ),
+        }
+}
+
+func (w Writer) Err() error {
+        for _, fn := range w.deferHandlers {
+                fn()
+        }
+        return w.err
+}
+
+func (w Writer) Chain(fns... handler) Writer {
+        for _, fn := range fns {
+                w = w.Bind(fn)
+        }
+        return w
+}
+
+func (w Writer) OnErrorFn(fn errorHandler) Writer {
+        if w.err != nil {
+                fn(w.err)
+        }
+        return w
+}
+
+func (w Writer) Entries() []Entry {
+        return appendEntries(nil, w.entries...)
+}
+
+func (w Writer) Flush(ctx context.Context, h slog.Handler) error {
+        errs := []error{}
+        for _, entry := range w.entries {
+                if !h.Enabled(ctx, entry.Level) {
+                        continue
+                }
+
+                record := slog.NewRecord(entry.Time, entry.Level, entry.Message, 0)
+                record.AddAttrs(entry.Attrs...)
+                if err := h.Handle(ctx, record); err != nil {
+                        errs = append(errs, err)
+                }
+        }
+        return errors.Join(errs...)
+}
+
+func (w Writer) augment(result Writer) Writer {
+        return Writer{
+                value:         result.value,
+                err:           result.err,
+                entries:       appendEntries(w.entries, result.entries...),
+                deferHandlers: w.deferHandlers,
+        }
+}
+
+func appendEntries(entries []Entry, more... Entry) []Entry {
+        result := make([]Entry, 0, len(entries)+len(more))
+        result = append(result, entries...)
+        return append(result, more...)
+}
+
+func buildWriter(value *{{T}}, err error) Writer {
+        return Writer{
+                value:         value,
+                err:           err,
+                entries:       []Entry{},
+                deferHandlers: []deferHandler{},
+        }
+}
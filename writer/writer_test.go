@@ -0,0 +1,117 @@
+//go:generate nanotemplate -T string --input=_writer.tt.go
+//go:generate nanotemplate -T int --input=_writer.tt.go
+package writer
+
+import (
+	"context"
+	"errors"
+	"github.com/nanoservice/monad.go/writer/writer_int"
+	"github.com/nanoservice/monad.go/writer/writer_string"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"testing"
+)
+
+type recordingHandler struct {
+	level   slog.Level
+	records []slog.Record
+}
+
+func (h *recordingHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level
+}
+
+func (h *recordingHandler) Handle(_ context.Context, r slog.Record) error {
+	h.records = append(h.records, r)
+	return nil
+}
+
+func (h *recordingHandler) WithAttrs(_ []slog.Attr) slog.Handler { return h }
+func (h *recordingHandler) WithGroup(_ string) slog.Handler      { return h }
+
+func messages(entries []writer_int.Entry) (result []string) {
+	for _, entry := range entries {
+		result = append(result, entry.Message)
+	}
+	return
+}
+
+func TestBindCollectsEntries(t *testing.T) {
+	w := writer_int.Success(7).
+		Tell(slog.LevelInfo, "started").
+		Chain(
+			func(x int) writer_int.Writer {
+				return writer_int.Success(x+2).Tell(slog.LevelInfo, "added")
+			},
+			func(x int) writer_int.Writer {
+				return writer_int.Success(x*2).Tell(slog.LevelDebug, "doubled")
+			},
+		)
+
+	assert.Equal(t, nil, w.Err())
+	assert.Equal(t, []string{"started", "added", "doubled"}, messages(w.Entries()))
+}
+
+func TestFailureKeepsEntriesCollectedSoFar(t *testing.T) {
+	err := errors.New("The error")
+	executed := false
+
+	w := writer_int.Success(1).Chain(
+		func(x int) writer_int.Writer {
+			return writer_int.Success(x).Tell(slog.LevelInfo, "first")
+		},
+		func(x int) writer_int.Writer {
+			return writer_int.Failure(err).Tell(slog.LevelError, "second")
+		},
+		func(x int) writer_int.Writer {
+			executed = true
+			return writer_int.Success(x).Tell(slog.LevelInfo, "third")
+		},
+	)
+
+	assert.Equal(t, err, w.Err())
+	assert.Equal(t, false, executed)
+	assert.Equal(t, []string{"first", "second"}, messages(w.Entries()))
+}
+
+func TestEntriesAreNotShared(t *testing.T) {
+	base := writer_string.Success("a").Tell(slog.LevelInfo, "base")
+	left := base.Tell(slog.LevelInfo, "left")
+	right := base.Tell(slog.LevelInfo, "right")
+
+	assert.Equal(t, 1, len(base.Entries()))
+	assert.Equal(t, "left", left.Entries()[1].Message)
+	assert.Equal(t, "right", right.Entries()[1].Message)
+}
+
+func TestFlush(t *testing.T) {
+	h := &recordingHandler{level: slog.LevelInfo}
+
+	err := writer_string.Success("world").
+		Tell(slog.LevelDebug, "ignored").
+		Tell(slog.LevelInfo, "greeted", slog.String("name", "world")).
+		Flush(context.Background(), h)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(h.records))
+	assert.Equal(t, "greeted", h.records[0].Message)
+
+	h.records[0].Attrs(func(attr slog.Attr) bool {
+		assert.Equal(t, slog.String("name", "world"), attr)
+		return true
+	})
+}
+
+func TestDeferOnSuccess(t *testing.T) {
+	var got int
+
+	writer_int.
+		Success(35).
+		Defer(func(x int) { got = x }).
+		Bind(func(x int) writer_int.Writer {
+			return writer_int.Failure(errors.New("The error"))
+		}).
+		Err()
+
+	assert.Equal(t, 35, got)
+}